@@ -0,0 +1,128 @@
+package fasturl
+
+// CanonicalOptions controls the normalizations of Canonicalize.
+//
+// More detail see https://tools.ietf.org/html/rfc3986#section-6
+type CanonicalOptions struct {
+	// LowercaseScheme lowercases the protocol.
+	LowercaseScheme bool
+	// LowercaseHost lowercases the ASCII letters of the host.
+	LowercaseHost bool
+	// UppercasePercentEncoding uppercases the hex digits of %xx.
+	UppercasePercentEncoding bool
+	// DecodeUnreserved decodes the %xx of the unreserved characters, like
+	// %7E to ~.
+	DecodeUnreserved bool
+	// RemoveDefaultPort removes the default port of the protocol.
+	RemoveDefaultPort bool
+	// RemoveDotSegments removes the "." and ".." segments of the path.
+	RemoveDotSegments bool
+	// EmptyPathSlash sets the empty path of the url with an authority to "/".
+	EmptyPathSlash bool
+	// RemoveTrailingDot removes the trailing dot of the domain, like
+	// example.com. to example.com.
+	RemoveTrailingDot bool
+	// RemoveFragment removes the hash.
+	RemoveFragment bool
+}
+
+// DefaultCanonicalOptions returns the options of the normalizations which
+// preserve the semantics, see RFC 3986 section 6.2.2 and 6.2.3.
+func DefaultCanonicalOptions() CanonicalOptions {
+	return CanonicalOptions{
+		LowercaseScheme:          true,
+		LowercaseHost:            true,
+		UppercasePercentEncoding: true,
+		DecodeUnreserved:         true,
+		RemoveDefaultPort:        true,
+		RemoveDotSegments:        true,
+		EmptyPathSlash:           true,
+	}
+}
+
+// Canonicalize canonicalizes the url with the options.
+func (f *FastURL) Canonicalize(opts CanonicalOptions) {
+	Canonicalize(f, opts)
+}
+
+// Canonicalize canonicalizes dst in place with the options.
+func Canonicalize(dst *FastURL, opts CanonicalOptions) {
+	if opts.LowercaseScheme {
+		toLowercsaeASCII(dst.protocol)
+		dst.scheme = lookupScheme(dst.protocol)
+	}
+
+	rebuild := false
+	if opts.LowercaseHost {
+		toLowercsaeASCII(dst.hostname)
+		rebuild = true
+	}
+	if opts.RemoveTrailingDot && dst.hostType != HostTypeOpaque && dst.hostType != HostTypeIPv6 {
+		if n := len(dst.hostname); n > 1 && dst.hostname[n-1] == '.' {
+			dst.hostname = dst.hostname[:n-1]
+			rebuild = true
+		}
+	}
+	if opts.RemoveDefaultPort && dst.scheme != nil && len(dst.port) > 0 && string(dst.port) == dst.scheme.DefaultPort {
+		dst.port = dst.port[:0]
+		rebuild = true
+	}
+	if rebuild {
+		buildHost(dst)
+	}
+	classifyHost(dst)
+
+	if opts.UppercasePercentEncoding || opts.DecodeUnreserved {
+		upper, decode := opts.UppercasePercentEncoding, opts.DecodeUnreserved
+		dst.user = normalizePercentEncoding(dst.user, upper, decode)
+		dst.pass = normalizePercentEncoding(dst.pass, upper, decode)
+		dst.pathname = normalizePercentEncoding(dst.pathname, upper, decode)
+		dst.rawquery = normalizePercentEncoding(dst.rawquery, upper, decode)
+		dst.hash = normalizePercentEncoding(dst.hash, upper, decode)
+	}
+
+	if !dst.opaque {
+		if opts.RemoveDotSegments {
+			dst.pathname = removeDotSegments(dst.pathname)
+		}
+		if opts.EmptyPathSlash && dst.hasAuthority && len(dst.pathname) == 0 {
+			dst.pathname = append(dst.pathname, '/')
+		}
+		dst.normalizedPathname = NormalizePathname(dst.normalizedPathname[:0], dst.pathname)
+	}
+
+	if opts.RemoveFragment {
+		dst.hash = dst.hash[:0]
+	}
+}
+
+// normalizePercentEncoding normalizes the %xx of b in place, upper
+// uppercases the hex digits and decode decodes the unreserved characters.
+func normalizePercentEncoding(b []byte, upper, decode bool) []byte {
+	const hex = "0123456789ABCDEF"
+
+	w := 0
+	for i := 0; i < len(b); i++ {
+		c := b[i]
+		if c != '%' || i+2 >= len(b) || !ishex(b[i+1]) || !ishex(b[i+2]) {
+			b[w] = c
+			w++
+			continue
+		}
+
+		v := unhex(b[i+1])<<4 | unhex(b[i+2])
+		switch {
+		case decode && isUnreserved(v):
+			b[w] = v
+			w++
+		case upper:
+			b[w], b[w+1], b[w+2] = '%', hex[v>>4], hex[v&15]
+			w += 3
+		default:
+			b[w], b[w+1], b[w+2] = '%', b[i+1], b[i+2]
+			w += 3
+		}
+		i += 2
+	}
+	return b[:w]
+}
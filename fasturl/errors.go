@@ -0,0 +1,155 @@
+package fasturl
+
+import "fmt"
+
+// Component is the component of the url.
+type Component int
+
+const (
+	// ComponentScheme is the protocol.
+	ComponentScheme Component = 1 + iota
+	// ComponentUserinfo is the username and password.
+	ComponentUserinfo
+	// ComponentHost is the hostname.
+	ComponentHost
+	// ComponentPort is the port.
+	ComponentPort
+	// ComponentPath is the pathname.
+	ComponentPath
+	// ComponentQuery is the query.
+	ComponentQuery
+	// ComponentFragment is the hash.
+	ComponentFragment
+)
+
+// String returns the name of the component.
+func (c Component) String() string {
+	switch c {
+	case ComponentScheme:
+		return "scheme"
+	case ComponentUserinfo:
+		return "userinfo"
+	case ComponentHost:
+		return "host"
+	case ComponentPort:
+		return "port"
+	case ComponentPath:
+		return "path"
+	case ComponentQuery:
+		return "query"
+	case ComponentFragment:
+		return "fragment"
+	}
+	return "unknown"
+}
+
+// ErrorKind is the kind of the ParseError.
+type ErrorKind int
+
+const (
+	// ErrorKindInvalidCharacter indicates a character which is not allowed.
+	ErrorKindInvalidCharacter ErrorKind = 1 + iota
+	// ErrorKindInvalidPercentEncoding indicates a malformed %XX sequence.
+	ErrorKindInvalidPercentEncoding
+	// ErrorKindInvalidIPv4 indicates an invalid IPv4 address.
+	ErrorKindInvalidIPv4
+	// ErrorKindInvalidIPv6 indicates an invalid IPv6 address.
+	ErrorKindInvalidIPv6
+	// ErrorKindInvalidDomain indicates a domain that is invalid, either
+	// rejected by IDNA or containing a forbidden code point.
+	ErrorKindInvalidDomain
+	// ErrorKindInvalidPort indicates an invalid port.
+	ErrorKindInvalidPort
+	// ErrorKindPortOutOfRange indicates a port greater than 65535.
+	ErrorKindPortOutOfRange
+	// ErrorKindMissingHost indicates the protocol requires a host.
+	ErrorKindMissingHost
+	// ErrorKindUnexpectedCredentials indicates the protocol forbids credentials.
+	ErrorKindUnexpectedCredentials
+)
+
+// String returns the description of the error kind.
+func (k ErrorKind) String() string {
+	switch k {
+	case ErrorKindInvalidCharacter:
+		return "invalid character"
+	case ErrorKindInvalidPercentEncoding:
+		return "invalid percent-encoding"
+	case ErrorKindInvalidIPv4:
+		return "invalid IPv4 address"
+	case ErrorKindInvalidIPv6:
+		return "invalid IPv6 address"
+	case ErrorKindInvalidDomain:
+		return "invalid domain"
+	case ErrorKindInvalidPort:
+		return "invalid port"
+	case ErrorKindPortOutOfRange:
+		return "port out of range"
+	case ErrorKindMissingHost:
+		return "missing host"
+	case ErrorKindUnexpectedCredentials:
+		return "unexpected credentials"
+	}
+	return "unknown error"
+}
+
+// ParseError describes where and why the url is invalid.
+//
+// Every ParseError wraps ErrFastURLInvalidCharacter, so
+// errors.Is(err, ErrFastURLInvalidCharacter) reports true for it.
+type ParseError struct {
+	// Component is the component which is invalid.
+	Component Component
+	// Kind is the kind of the error.
+	Kind ErrorKind
+	// Offset is the byte offset of Bytes in the input.
+	Offset int
+	// Bytes is a copy of the offending bytes.
+	Bytes []byte
+}
+
+// Error implements the error interface.
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("fasturl: %s in %s at offset %d: %q", e.Kind, e.Component, e.Offset, e.Bytes)
+}
+
+// Unwrap returns ErrFastURLInvalidCharacter.
+func (e *ParseError) Unwrap() error {
+	return ErrFastURLInvalidCharacter
+}
+
+// newParseError creates the ParseError for the n bytes of src at offset i,
+// base is the offset of src in the input.
+func newParseError(component Component, kind ErrorKind, src []byte, base, i, n int) *ParseError {
+	if i+n > len(src) {
+		n = len(src) - i
+	}
+	return &ParseError{
+		Component: component,
+		Kind:      kind,
+		Offset:    base + i,
+		Bytes:     append([]byte(nil), src[i:i+n]...),
+	}
+}
+
+// checkASCIIControl checks that src, which is at offset base of the input,
+// contains no ASCII control character.
+func checkASCIIControl(component Component, src []byte, base int) error {
+	if !hasASCIIControl(src) {
+		return nil
+	}
+	for i := 0; i < len(src); i++ {
+		if src[i] < ' ' || src[i] == 0x7f {
+			return newParseError(component, ErrorKindInvalidCharacter, src, base, i, 1)
+		}
+	}
+	return nil
+}
+
+// offsetError shifts the offset of the ParseError by base.
+func offsetError(err error, base int) error {
+	if pe, ok := err.(*ParseError); ok {
+		pe.Offset += base
+	}
+	return err
+}
@@ -0,0 +1,64 @@
+package fasturl
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseError(t *testing.T) {
+	for _, tt := range []struct {
+		input     string
+		component Component
+		kind      ErrorKind
+		offset    int
+		bytes     string
+	}{
+		{"http://a\tb/", ComponentHost, ErrorKindInvalidCharacter, 8, "\t"},
+		{"http://a:8\t/", ComponentPort, ErrorKindInvalidCharacter, 10, "\t"},
+		{"http://u\x01@a/", ComponentUserinfo, ErrorKindInvalidCharacter, 8, "\x01"},
+		{"http://a/b\nc", ComponentPath, ErrorKindInvalidCharacter, 10, "\n"},
+		{"http://a/?q=\x7f", ComponentQuery, ErrorKindInvalidCharacter, 12, "\x7f"},
+		{"http://[::1", ComponentHost, ErrorKindInvalidIPv6, 7, "[::1"},
+		{"http://[::g]:80/", ComponentHost, ErrorKindInvalidIPv6, 7, "[::g]"},
+		{"http://[::1]x/", ComponentHost, ErrorKindInvalidCharacter, 12, "x"},
+		{"http://1.2.3.256/", ComponentHost, ErrorKindInvalidIPv4, 7, "1.2.3.256"},
+		{"http://user@/a", ComponentHost, ErrorKindMissingHost, 12, ""},
+		{"file://u@h/", ComponentUserinfo, ErrorKindUnexpectedCredentials, 7, "u@"},
+		{"file://h:21/", ComponentPort, ErrorKindInvalidPort, 9, "21"},
+	} {
+		var f FastURL
+		err := f.Parse([]byte(tt.input))
+		require.NotNil(t, err, tt.input)
+		require.True(t, errors.Is(err, ErrFastURLInvalidCharacter), tt.input)
+
+		var pe *ParseError
+		require.True(t, errors.As(err, &pe), tt.input)
+		require.Equal(t, tt.component, pe.Component, tt.input)
+		require.Equal(t, tt.kind, pe.Kind, tt.input)
+		require.Equal(t, tt.offset, pe.Offset, tt.input)
+		require.Equal(t, tt.bytes, string(pe.Bytes), tt.input)
+	}
+}
+
+func TestParseErrorQuery(t *testing.T) {
+	var q Query
+	err := ParseQuery(&q, []byte("a=1&b=%zz&c=3"))
+	require.True(t, errors.Is(err, ErrFastURLInvalidCharacter))
+
+	var pe *ParseError
+	require.True(t, errors.As(err, &pe))
+	require.Equal(t, ComponentQuery, pe.Component)
+	require.Equal(t, ErrorKindInvalidPercentEncoding, pe.Kind)
+	require.Equal(t, 6, pe.Offset)
+	require.Equal(t, "%zz", string(pe.Bytes))
+	require.Equal(t, `fasturl: invalid percent-encoding in query at offset 6: "%zz"`, err.Error())
+	require.Equal(t, 1, q.Len())
+
+	q.Reset()
+	err = ParseQuery(&q, []byte("a=1;%4"))
+	require.True(t, errors.As(err, &pe))
+	require.Equal(t, 4, pe.Offset)
+	require.Equal(t, "%4", string(pe.Bytes))
+}
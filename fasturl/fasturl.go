@@ -3,11 +3,15 @@ package fasturl
 import (
 	"bytes"
 	"errors"
+	"strconv"
 )
 
 var (
 	// ErrFastURLInvalidCharacter indicates the url contains invalid character.
 	ErrFastURLInvalidCharacter = errors.New("fasturl: invalid character in url")
+	// ErrFastURLOpaqueBase indicates the relative reference can not be
+	// resolved against the url with an opaque path.
+	ErrFastURLOpaqueBase = errors.New("fasturl: relative reference against opaque path")
 )
 
 // FastURL presents the URL.
@@ -32,22 +36,28 @@ var (
 // (all spaces in the "" line should be ignored — they are purely for formatting)
 // More detail see https://url.spec.whatwg.org/
 //
-//[protocol:][//[auth@]host][/]pathname[?query][#hash]
+// [protocol:][//[auth@]host][/]pathname[?query][#hash]
 type FastURL struct {
-	protocol           []byte
-	auth               []byte
-	user               []byte
-	pass               []byte
-	host               []byte
-	hostname           []byte
-	port               []byte
-	path               []byte
-	pathname           []byte
-	normalizedPathname []byte
-	parsequery         bool
-	query              Query
-	rawquery           []byte
-	hash               []byte
+	protocol            []byte
+	scheme              *Scheme
+	auth                []byte
+	user                []byte
+	pass                []byte
+	host                []byte
+	hostname            []byte
+	unbracketedHostname []byte
+	port                []byte
+	hostType            HostType
+	path                []byte
+	pathname            []byte
+	opaque              bool
+	hasAuthority        bool
+	normalizedPathname  []byte
+	parsequery          bool
+	query               Query
+	rawquery            []byte
+	hash                []byte
+	validationErrors    []ValidationError
 }
 
 // GetProtocol gets the protocol.
@@ -58,6 +68,18 @@ func (f *FastURL) GetProtocol() []byte {
 // SetProtocol sets the protocol.
 func (f *FastURL) SetProtocol(p string) {
 	f.protocol = append(f.protocol[:0], p...)
+	toLowercsaeASCII(f.protocol)
+	f.scheme = lookupScheme(f.protocol)
+	classifyHost(f)
+}
+
+// GetScheme gets a copy of the registered scheme of the protocol, the bool is
+// false if the protocol is not registered.
+func (f *FastURL) GetScheme() (Scheme, bool) {
+	if f.scheme == nil {
+		return Scheme{}, false
+	}
+	return *f.scheme, true
 }
 
 // GetAuth gets the auth.
@@ -90,14 +112,65 @@ func (f *FastURL) GetHost() []byte {
 	return f.host
 }
 
+// GetHostType gets the type of the host.
+func (f *FastURL) GetHostType() HostType {
+	return f.hostType
+}
+
 // GetHostname gets the hostname.
 func (f *FastURL) GetHostname() []byte {
 	return f.hostname
 }
 
-// SetHostname sets the hostname.
+// GetHostnameUnbracketed gets the hostname without the brackets around an
+// IPv6 literal and with its zone unescaped, which is the form net.Dial expects.
+func (f *FastURL) GetHostnameUnbracketed() []byte {
+	h := f.hostname
+	if len(h) < 2 || h[0] != '[' || h[len(h)-1] != ']' {
+		return h
+	}
+	h = h[1 : len(h)-1]
+
+	zi := bytes.Index(h, []byte("%25"))
+	if zi < 0 {
+		return h
+	}
+
+	f.unbracketedHostname = append(f.unbracketedHostname[:0], h[:zi]...)
+	f.unbracketedHostname = append(f.unbracketedHostname, '%')
+	n := len(f.unbracketedHostname)
+	zone, err := unescape(f.unbracketedHostname, h[zi+3:], encodeZone)
+	if err != nil {
+		zone = append(f.unbracketedHostname[:n], h[zi+3:]...)
+	}
+	f.unbracketedHostname = zone
+	return f.unbracketedHostname
+}
+
+// SetHostname sets the hostname, the type of the host is reclassified from
+// the hostname and the protocol.
 func (f *FastURL) SetHostname(hostname string) {
 	f.hostname = append(f.hostname[:0], hostname...)
+	classifyHost(f)
+	buildHost(f)
+}
+
+// AppendHostnameASCII appends the hostname to dst, converting a domain to
+// its ASCII form.
+func (f *FastURL) AppendHostnameASCII(dst []byte) ([]byte, error) {
+	if f.hostType != HostTypeDomain {
+		return append(dst, f.hostname...), nil
+	}
+	return DomainToASCII(dst, f.hostname)
+}
+
+// AppendHostnameUnicode appends the hostname to dst, converting a domain to
+// its Unicode form.
+func (f *FastURL) AppendHostnameUnicode(dst []byte) ([]byte, error) {
+	if f.hostType != HostTypeDomain {
+		return append(dst, f.hostname...), nil
+	}
+	return DomainToUnicode(dst, f.hostname)
 }
 
 // GetPort gets the port.
@@ -105,9 +178,49 @@ func (f *FastURL) GetPort() []byte {
 	return f.port
 }
 
+// GetPortOrDefault gets the port, or the default port of the protocol if the
+// url has no port.
+func (f *FastURL) GetPortOrDefault() []byte {
+	if len(f.port) == 0 && f.scheme != nil {
+		return s2b(f.scheme.DefaultPort)
+	}
+	return f.port
+}
+
 // SetPort sets the port.
 func (f *FastURL) SetPort(port string) {
 	f.port = append(f.port[:0], port...)
+	buildHost(f)
+}
+
+// GetPortNumber gets the port as a number, the bool is false if the url has
+// no port.
+func (f *FastURL) GetPortNumber() (uint16, bool) {
+	if len(f.port) == 0 {
+		return 0, false
+	}
+	v := 0
+	for i := 0; i < len(f.port); i++ {
+		c := f.port[i]
+		if c < '0' || c > '9' {
+			return 0, false
+		}
+		v = v*10 + int(c-'0')
+		if v > 65535 {
+			return 0, false
+		}
+	}
+	return uint16(v), true
+}
+
+// SetPortNumber sets the port as a number, the default port of the protocol
+// is elided.
+func (f *FastURL) SetPortNumber(port uint16) {
+	f.port = strconv.AppendUint(f.port[:0], uint64(port), 10)
+	if f.scheme != nil && string(f.port) == f.scheme.DefaultPort {
+		f.port = f.port[:0]
+	}
+	buildHost(f)
 }
 
 // GetPathname gets the pathname.
@@ -120,6 +233,12 @@ func (f *FastURL) SetPathname(p string) {
 	f.pathname = append(f.pathname[:0], p...)
 }
 
+// IsOpaque reports whether the url has an opaque path, like
+// mailto:user@example.com, which has no authority and is not hierarchical.
+func (f *FastURL) IsOpaque() bool {
+	return f.opaque
+}
+
 // GetNormalizedPathname gets the normalized pathname.
 func (f *FastURL) GetNormalizedPathname() []byte {
 	return f.normalizedPathname
@@ -134,6 +253,7 @@ func (f *FastURL) GetRawQuery() []byte {
 func (f *FastURL) GetQuery() *Query {
 	if !f.parsequery {
 		f.query.Decode(f.rawquery)
+		f.query.modified = false
 		f.parsequery = true
 	}
 	return &f.query
@@ -149,6 +269,12 @@ func (f *FastURL) SetHash(hash string) {
 	f.hash = append(f.hash[:0], hash...)
 }
 
+// GetValidationErrors gets the validation errors recorded by the parser when
+// ParseOptions.ValidationErrors is set, they are ordered by offset.
+func (f *FastURL) GetValidationErrors() []ValidationError {
+	return f.validationErrors
+}
+
 // Parse parses the url.
 func (f *FastURL) Parse(url []byte) error {
 	return Parse(f, url)
@@ -159,6 +285,22 @@ func (f *FastURL) ParseWithoutProtocol(url []byte) error {
 	return ParseWithoutProtocol(f, url)
 }
 
+// ParseStrict parses the url, rejecting the url which does not conform to
+// RFC 3986.
+func (f *FastURL) ParseStrict(url []byte) error {
+	return ParseStrict(f, url)
+}
+
+// ParseRelative parses the input and resolves it against the base.
+func (f *FastURL) ParseRelative(base *FastURL, input []byte) error {
+	return ParseRelative(f, base, input)
+}
+
+// ParseWithOptions parses the url with the options.
+func (f *FastURL) ParseWithOptions(url []byte, o ParseOptions) error {
+	return ParseWithOptions(f, url, o)
+}
+
 // Encode encodes to []byte.
 func (f *FastURL) Encode(b []byte) []byte {
 	if len(f.protocol) > 0 {
@@ -166,13 +308,57 @@ func (f *FastURL) Encode(b []byte) []byte {
 		b = append(b, ':')
 	}
 
+	if f.opaque {
+		b = PercentEncode(b, f.pathname, &opaquePathEncodeSet)
+	} else {
+		b = f.encodeHierarchical(b)
+	}
+
+	b = f.appendSearch(b)
+	b = f.appendHash(b)
+
+	return b
+}
+
+// appendSearch appends '?' and the query if the url has the query. The raw
+// query is kept byte for byte until the query is modified.
+func (f *FastURL) appendSearch(b []byte) []byte {
+	return appendSearch(b, f.parsequery, &f.query, f.rawquery)
+}
+
+func appendSearch(b []byte, parsequery bool, query *Query, rawquery []byte) []byte {
+	if parsequery && query.modified {
+		if query.Len() > 0 {
+			b = append(b, '?')
+			b = query.Encode(b)
+		}
+		return b
+	}
+	if len(rawquery) > 0 {
+		b = append(b, '?')
+		b = append(b, rawquery...)
+	}
+	return b
+}
+
+func (f *FastURL) encodeHierarchical(b []byte) []byte {
 	var appendslash bool
-	if len(f.user) > 0 || len(f.pass) > 0 {
+	special := f.scheme != nil && f.scheme.Special
+	if special || f.hasAuthority && len(f.protocol) > 0 {
+		// Special urls always have an authority, the empty one is kept
 		b = append(b, "//"...)
 		appendslash = true
-		b = append(b, f.user...)
+	}
+
+	if len(f.user) > 0 || len(f.pass) > 0 {
+		if !appendslash {
+			b = append(b, "//"...)
+			appendslash = true
+		}
+		// Parse encodes the userinfo with the same set
+		b = PercentEncode(b, f.user, &UserinfoPercentEncodeSet)
 		b = append(b, ':')
-		b = append(b, f.pass...)
+		b = PercentEncode(b, f.pass, &UserinfoPercentEncodeSet)
 		b = append(b, '@')
 	}
 
@@ -181,10 +367,20 @@ func (f *FastURL) Encode(b []byte) []byte {
 			b = append(b, "//"...)
 			appendslash = true
 		}
-		b = append(b, f.hostname...)
+		switch {
+		case f.hostname[0] == '[':
+			b = append(b, f.hostname...)
+		case f.hostType == HostTypeIPv6 || bytes.IndexByte(f.hostname, ':') >= 0:
+			// IPv6 literal
+			b = append(b, '[')
+			b = append(b, f.hostname...)
+			b = append(b, ']')
+		default:
+			b = escapeComponent(b, f.hostname, encodeHost)
+		}
 	}
 
-	if len(f.port) > 0 {
+	if len(f.port) > 0 && (f.scheme == nil || string(f.port) != f.scheme.DefaultPort) {
 		if !appendslash {
 			b = append(b, "//"...)
 			appendslash = true
@@ -193,148 +389,349 @@ func (f *FastURL) Encode(b []byte) []byte {
 		b = append(b, f.port...)
 	}
 
-	if len(f.pathname) > 0 {
-		b = append(b, f.pathname...)
+	switch {
+	case len(f.pathname) == 0:
+		if appendslash {
+			// Parse takes the empty path after an authority for "/"
+			b = append(b, '/')
+		}
+	case appendslash && f.pathname[0] != '/':
+		// The path after an authority is absolute
+		b = append(b, '/')
+	case !appendslash && len(f.pathname) > 1 && f.pathname[0] == '/' && f.pathname[1] == '/':
+		// The path would be taken for an authority
+		b = append(b, "/."...)
 	}
+	return appendPath(b, f.pathname, special)
+}
 
-	if f.query.Len() > 0 {
-		b = append(b, '?')
-		b = f.query.Encode(b)
+// appendPath appends the escaped path.
+func appendPath(b, p []byte, special bool) []byte {
+	if special {
+		// The special path takes '\' for '/'
+		for i := bytes.IndexByte(p, '\\'); i >= 0; i = bytes.IndexByte(p, '\\') {
+			b = escapeComponent(b, p[:i], encodePath)
+			b = append(b, "%5C"...)
+			p = p[i+1:]
+		}
 	}
+	return escapeComponent(b, p, encodePath)
+}
 
-	if len(f.hash) > 0 {
-		b = append(b, f.hash...)
+// appendHash appends the hash with its leading '#'.
+func (f *FastURL) appendHash(b []byte) []byte {
+	if len(f.hash) == 0 {
+		return b
 	}
-
-	return b
+	h := f.hash
+	if h[0] == '#' {
+		h = h[1:]
+	}
+	b = append(b, '#')
+	return escapeComponent(b, h, encodeFragment)
 }
 
 // Reset resets the FastURL.
 func (f *FastURL) Reset() {
 	f.protocol = f.protocol[:0]
+	f.scheme = nil
 	f.auth = f.auth[:0]
 	f.user = f.user[:0]
 	f.pass = f.pass[:0]
 	f.host = f.host[:0]
 	f.hostname = f.hostname[:0]
+	f.unbracketedHostname = f.unbracketedHostname[:0]
 	f.port = f.port[:0]
+	f.hostType = HostTypeNone
 	f.pathname = f.pathname[:0]
+	f.opaque = false
+	f.hasAuthority = false
 	f.normalizedPathname = f.normalizedPathname[:0]
 	f.rawquery = f.rawquery[:0]
 	f.hash = f.hash[:0]
 	f.parsequery = false
 	f.query.Reset()
+	f.validationErrors = f.validationErrors[:0]
 }
 
 // ParseWithoutProtocol parses the url to FastURL without protocol.
 func ParseWithoutProtocol(f *FastURL, url []byte) error {
-	return parse(f, url, parseOption{
-		ParseProtocol: false,
+	return parse(f, url, ParseOptions{
+		WithoutProtocol: true,
 	})
 }
 
 // Parse parses the url to FastURL.
 func Parse(f *FastURL, url []byte) error {
-	return parse(f, url, parseOption{
-		ParseProtocol: true,
-	})
+	return parse(f, url, ParseOptions{})
+}
+
+// ParseWithOptions parses the url to FastURL with the options.
+func ParseWithOptions(f *FastURL, url []byte, o ParseOptions) error {
+	return parse(f, url, o)
 }
 
-type parseOption struct {
-	ParseProtocol bool
+// isSlash reports whether c is a slash, special treats the backslash as a
+// slash.
+func isSlash(c byte, special bool) bool {
+	return c == '/' || (c == '\\' && special)
 }
 
-func parse(f *FastURL, url []byte, o parseOption) error {
+// ParseOptions controls the optional behaviours of the parser.
+type ParseOptions struct {
+	// WithoutProtocol parses the url without protocol.
+	WithoutProtocol bool
+	// IDNA converts internationalised domain names to their ASCII form.
+	IDNA bool
+	// ValidationErrors records the WHATWG validation errors, which do not
+	// fail the parsing, see GetValidationErrors.
+	ValidationErrors bool
+	// Strict rejects the url which does not conform to RFC 3986.
+	Strict bool
+	// Reference parses the url as a RFC 3986 URI reference, see
+	// ResolveReference. The url without protocol has an authority only if
+	// it starts with "//" and keeps its relative path.
+	Reference bool
+
+	// base is the scheme of the base url of the reference.
+	base *Scheme
+}
+
+func parse(f *FastURL, url []byte, o ParseOptions) error {
+	f.validationErrors = f.validationErrors[:0]
+
+	if o.Strict {
+		if err := checkRFC3986(url, o.WithoutProtocol); err != nil {
+			return err
+		}
+	}
+
 	// Find hash
+	var fragment []byte
 	hashIndex := bytes.IndexByte(url, '#')
 	if hashIndex >= 0 {
+		if err := checkASCIIControl(ComponentFragment, url[hashIndex+1:], hashIndex+1); err != nil {
+			return err
+		}
 		f.hash = append(f.hash, url[hashIndex:]...)
-		url = url[:hashIndex]
-	}
-
-	if hasASCIIControl(url) {
-		return ErrFastURLInvalidCharacter
+		url, fragment = url[:hashIndex], url[hashIndex+1:]
 	}
 
 	// Find query
 	queryIndex := bytes.IndexByte(url, '?')
 	if queryIndex >= 0 {
+		if err := checkASCIIControl(ComponentQuery, url[queryIndex+1:], queryIndex+1); err != nil {
+			return err
+		}
 		f.rawquery = append(f.rawquery[:0], url[queryIndex+1:]...)
 		url = url[:queryIndex]
 	}
 
 	pos := 0
-	if o.ParseProtocol {
+	if !o.WithoutProtocol {
+		// Find protocol
+		if pi := bytes.IndexByte(url, ':'); pi >= 0 && isValidScheme(url[:pi]) {
+			f.protocol = append(f.protocol[:0], url[:pi]...)
+			toLowercsaeASCII(f.protocol)
+			pos = pi + 1
+		}
+	}
+	f.scheme = lookupScheme(f.protocol)
+	scheme := f.scheme
+	// The strict url without protocol is a relative-ref, so its path is
+	// not taken for an authority unless it starts with //
+	relative := (o.Reference || o.Strict && !o.WithoutProtocol) && len(f.protocol) == 0
+	if relative {
+		// The reference follows the rules of the protocol of its base
+		scheme = o.base
+	}
+	special := scheme != nil && scheme.Special
+	file := special && scheme.Name == "file"
+
+	authority := true
+	if !o.WithoutProtocol {
 		// Trim //
-		if len(url) >= 2 && string(url[0:2]) == "//" {
-			pos += 2
-		} else {
-			// Find protocol
-			pi := bytes.IndexByte(url[pos:], ':')
-			if pi >= 0 {
-				f.protocol = append(f.protocol[:0], url[:pi]...)
-				toLowercsaeASCII(f.protocol)
-				pos += pi + 1
+		switch {
+		case relative:
+			if len(url[pos:]) >= 2 && isSlash(url[pos], special) && isSlash(url[pos+1], special) {
+				pos += 2
+			} else {
+				authority = false
 			}
-			// Trim //
-			if len(url[pos:]) >= 2 && string(url[pos:pos+2]) == "//" {
+		case file:
+			// file: has an authority only if it is followed by two slashes
+			if len(url[pos:]) >= 2 && isSlash(url[pos], true) && isSlash(url[pos+1], true) {
 				pos += 2
-
+			} else {
+				authority = false
+			}
+		case special:
+			if o.ValidationErrors && (len(url[pos:]) < 2 || string(url[pos:pos+2]) != "//") {
+				f.addValidationError(ValidationSpecialSchemeMissingFollowingSolidus, pos)
 			}
+			for pos < len(url) && isSlash(url[pos], true) {
+				if o.ValidationErrors && url[pos] == '\\' {
+					f.addValidationError(ValidationInvalidReverseSolidus, pos)
+				}
+				pos++
+			}
+		case len(url[pos:]) >= 2 && string(url[pos:pos+2]) == "//":
+			pos += 2
+		case len(f.protocol) == 0:
+			// The url without protocol starts with the authority
+		case len(url[pos:]) > 0 && url[pos] == '/':
+			authority = false
+		default:
+			// More detail see https://url.spec.whatwg.org/#cannot-be-a-base-url-path-state
+			f.opaque = true
+			authority = false
 		}
 	}
 
+	f.hasAuthority = authority
+
 	// Find auth
-	// TODO(detailylang): follow https://url.spec.whatwg.org/#authority-state
-	ai := bytes.IndexByte(url[pos:], '@')
+	// The authority ends at the first '/' or, for special protocols, '\'
+	// and the auth is everything before the last '@' inside it.
+	// More detail see https://url.spec.whatwg.org/#authority-state
+	end := pos
+	if authority {
+		end = len(url)
+		for i := pos; i < len(url); i++ {
+			if isSlash(url[i], special) {
+				end = i
+				break
+			}
+		}
+	}
+
+	ai := bytes.LastIndexByte(url[pos:end], '@')
 	if ai >= 0 {
+		if file {
+			return newParseError(ComponentUserinfo, ErrorKindUnexpectedCredentials, url, 0, pos, ai+1)
+		}
+		if err := checkASCIIControl(ComponentUserinfo, url[pos:pos+ai], pos); err != nil {
+			return err
+		}
+		if o.ValidationErrors {
+			f.addValidationError(ValidationInvalidCredentials, pos)
+		}
+
 		f.auth = append(f.auth[:0], url[pos:pos+ai]...)
 		// Find :
 		ci := bytes.IndexByte(f.auth, ':')
 		if ci >= 0 {
-			f.user = append(f.user[:0], f.auth[:ci]...)
-			f.pass = append(f.pass[:0], f.auth[ci+1:]...)
+			f.user = PercentEncode(f.user[:0], f.auth[:ci], &UserinfoPercentEncodeSet)
+			f.pass = PercentEncode(f.pass[:0], f.auth[ci+1:], &UserinfoPercentEncodeSet)
 		} else {
-			f.user = append(f.user[:0], f.auth...)
+			f.user = PercentEncode(f.user[:0], f.auth, &UserinfoPercentEncodeSet)
 		}
 
 		pos += ai + 1
 	}
 
 	// Find host
-	hi := bytes.IndexByte(url[pos:], '/')
-	if hi >= 0 {
-		f.host = append(f.host[:0], url[pos:pos+hi]...)
-		pos += hi
-
-	} else {
-		f.host = append(f.host[:0], url[pos:]...)
-		pos = len(url)
+	host := url[pos:end]
+	if pi := portIndex(host); pi >= 0 {
+		if err := checkASCIIControl(ComponentHost, host[:pi], pos); err != nil {
+			return err
+		}
+		if err := checkASCIIControl(ComponentPort, host[pi+1:], pos+pi+1); err != nil {
+			return err
+		}
+	} else if err := checkASCIIControl(ComponentHost, host, pos); err != nil {
+		return err
 	}
+
+	f.host = append(f.host[:0], host...)
 	toLowercsaeASCII(f.host)
 
 	if len(f.host) > 0 {
-		// find :
-		ci := bytes.IndexByte(f.host, ':')
-		if ci >= 0 {
-			f.hostname = append(f.hostname[:0], f.host[:ci]...)
-			f.port = append(f.port[:0], f.host[ci+1:]...)
-		} else {
-			f.hostname = append(f.hostname[:0], f.host...)
+		if err := parseHost(f, host, pos, special || len(f.protocol) == 0, o.IDNA, o.Strict); err != nil {
+			return err
+		}
+		if o.ValidationErrors && f.hostType == HostTypeIPv4 {
+			if pi := portIndex(host); pi >= 0 {
+				f.validateIPv4(host[:pi], pos)
+			} else {
+				f.validateIPv4(host, pos)
+			}
+		}
+
+		if err := parsePort(f, host, pos); err != nil {
+			return err
+		}
+		if scheme != nil && len(f.port) > 0 && string(f.port) == scheme.DefaultPort {
+			f.port = f.port[:0]
 		}
+		if file && len(f.port) > 0 {
+			pi := portIndex(host)
+			return newParseError(ComponentPort, ErrorKindInvalidPort, host, pos, pi+1, len(host))
+		}
+		if file && string(f.hostname) == "localhost" {
+			f.hostname = f.hostname[:0]
+			f.hostType = HostTypeNone
+		}
+		buildHost(f)
 	}
 
+	if scheme != nil && scheme.RequireHost && authority && len(f.hostname) == 0 {
+		return newParseError(ComponentHost, ErrorKindMissingHost, host, pos, 0, len(host))
+	}
+	pos = end
+
 	// Find path
-	if len(url[pos:]) > 0 && url[pos] != '/' {
-		f.pathname = append(f.pathname[:0], '/')
+	if err := checkASCIIControl(ComponentPath, url[pos:], pos); err != nil {
+		return err
 	}
 
-	f.pathname = append(f.pathname, url[pos:]...)
-	if len(f.pathname) == 0 {
-		f.pathname = append(f.pathname, '/')
+	if o.ValidationErrors {
+		f.validateURLUnits(url[pos:], pos, special)
 	}
 
-	f.normalizedPathname = NormalizePathname(f.normalizedPathname[:0], f.pathname)
+	switch {
+	case f.opaque:
+		f.pathname = append(f.pathname[:0], url[pos:]...)
+		f.normalizedPathname = append(f.normalizedPathname[:0], f.pathname...)
+	case relative && !authority:
+		// The relative path is resolved against the base, see ResolveReference
+		f.pathname = append(f.pathname[:0], url[pos:]...)
+		if special {
+			for i := range f.pathname {
+				if f.pathname[i] == '\\' {
+					f.pathname[i] = '/'
+				}
+			}
+		}
+		f.normalizedPathname = append(f.normalizedPathname[:0], f.pathname...)
+	default:
+		if len(url[pos:]) > 0 && !isSlash(url[pos], special) {
+			f.pathname = append(f.pathname[:0], '/')
+		}
+
+		f.pathname = append(f.pathname, url[pos:]...)
+		if len(f.pathname) == 0 {
+			f.pathname = append(f.pathname, '/')
+		}
+		if special {
+			for i := range f.pathname {
+				if f.pathname[i] == '\\' {
+					f.pathname[i] = '/'
+				}
+			}
+		}
+
+		f.normalizedPathname = NormalizePathname(f.normalizedPathname[:0], f.pathname)
+	}
+
+	if o.ValidationErrors {
+		if queryIndex >= 0 {
+			f.validateURLUnits(f.rawquery, queryIndex+1, false)
+		}
+		if hashIndex >= 0 {
+			f.validateURLUnits(fragment, hashIndex+1, false)
+		}
+	}
 
 	return nil
 }
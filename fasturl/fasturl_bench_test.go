@@ -2,6 +2,7 @@ package fasturl
 
 import (
 	"net/url"
+	"strings"
 	"testing"
 )
 
@@ -21,6 +22,14 @@ func BenchmarkNetURLParseCase2(b *testing.B) {
 	benchmarkNetURLParse(b, []byte("http://www.example.com/example/asdfasdf/../asdfasdf/1234?aaaa=1&q=2&aaa=3&fff=4&cc=3&123=f&ccc=3#bc"))
 }
 
+func BenchmarkFastURLViewParseCase1(b *testing.B) {
+	benchmarkFastURLViewParse(b, []byte("http://www.example.com/example?aaaa=1#bc"))
+}
+
+func BenchmarkFastURLViewParseCase2(b *testing.B) {
+	benchmarkFastURLViewParse(b, []byte("http://www.example.com/example/asdfasdf/../asdfasdf/1234?aaaa=1&q=2&aaa=3&fff=4&cc=3&123=f&ccc=3#bc"))
+}
+
 func benchmarkNetURLParse(b *testing.B, input []byte) {
 	var u url.URL
 	uu := string(input)
@@ -42,3 +51,146 @@ func benchmarkFastURLParse(b *testing.B, url []byte) {
 		}
 	}
 }
+
+func BenchmarkFastURLParseRelative(b *testing.B) {
+	var base, f FastURL
+	if err := base.Parse([]byte("http://www.example.com/static/css/a.css?v=1")); err != nil {
+		b.Fatal("unexpected error", err)
+	}
+	input := []byte("../img/a.png?v=2#bc")
+	for i := 0; i < b.N; i++ {
+		f.Reset()
+		err := f.ParseRelative(&base, input)
+		if err != nil {
+			b.Fatal("unexpected error", err)
+		}
+	}
+}
+
+func BenchmarkNetURLParseRelative(b *testing.B) {
+	base, err := url.Parse("http://www.example.com/static/css/a.css?v=1")
+	if err != nil {
+		b.Fatal("unexpected error", err)
+	}
+	for i := 0; i < b.N; i++ {
+		_, err := base.Parse("../img/a.png?v=2#bc")
+		if err != nil {
+			b.Fatal("unexpected error", err)
+		}
+	}
+}
+
+func BenchmarkFingerprint(b *testing.B) {
+	var f FastURL
+	if err := f.Parse([]byte("http://www.example.com/example/asdfasdf/../asdfasdf/1234?aaaa=1&q=2&aaa=3&fff=4&cc=3&123=f&ccc=3#bc")); err != nil {
+		b.Fatal("unexpected error", err)
+	}
+	opts := FingerprintOptions{SortQuery: true, DropParams: []string{"cc"}}
+	for i := 0; i < b.N; i++ {
+		Fingerprint(&f, opts)
+	}
+}
+
+var (
+	benchLongPath  = []byte("/" + strings.Repeat("segment-of-a-long-path/", 40) + "index.html")
+	benchLongQuery = []byte(strings.Repeat("name=value&another_name=another-value&", 25) + "last=%E4%BD%A0%E5%A5%BD+world")
+)
+
+func BenchmarkHasASCIIControlLongPath(b *testing.B) {
+	b.SetBytes(int64(len(benchLongPath)))
+	for i := 0; i < b.N; i++ {
+		if hasASCIIControl(benchLongPath) {
+			b.Fatal("unexpected control")
+		}
+	}
+}
+
+func BenchmarkHasASCIIControlBytewiseLongPath(b *testing.B) {
+	b.SetBytes(int64(len(benchLongPath)))
+	for i := 0; i < b.N; i++ {
+		if hasASCIIControlBytewise(benchLongPath) {
+			b.Fatal("unexpected control")
+		}
+	}
+}
+
+func BenchmarkHasASCIIControlShort(b *testing.B) {
+	input := []byte("/example?aaaa=1")
+	b.SetBytes(int64(len(input)))
+	for i := 0; i < b.N; i++ {
+		if hasASCIIControl(input) {
+			b.Fatal("unexpected control")
+		}
+	}
+}
+
+func BenchmarkEscapePathLongPath(b *testing.B) {
+	b.SetBytes(int64(len(benchLongPath)))
+	var dst []byte
+	for i := 0; i < b.N; i++ {
+		dst = EscapePath(dst[:0], benchLongPath)
+	}
+}
+
+func BenchmarkEscapeBytewiseLongPath(b *testing.B) {
+	b.SetBytes(int64(len(benchLongPath)))
+	var dst []byte
+	for i := 0; i < b.N; i++ {
+		dst = escapeBytewise(dst[:0], benchLongPath, encodePath)
+	}
+}
+
+func BenchmarkEscapeQueryLongQuery(b *testing.B) {
+	b.SetBytes(int64(len(benchLongQuery)))
+	var dst []byte
+	for i := 0; i < b.N; i++ {
+		dst = EscapeQuery(dst[:0], benchLongQuery)
+	}
+}
+
+func BenchmarkEscapeBytewiseLongQuery(b *testing.B) {
+	b.SetBytes(int64(len(benchLongQuery)))
+	var dst []byte
+	for i := 0; i < b.N; i++ {
+		dst = escapeBytewise(dst[:0], benchLongQuery, encodeQueryComponent)
+	}
+}
+
+func BenchmarkUnescapeQueryLongQuery(b *testing.B) {
+	b.SetBytes(int64(len(benchLongQuery)))
+	var dst []byte
+	for i := 0; i < b.N; i++ {
+		var err error
+		if dst, err = UnescapeQuery(dst[:0], benchLongQuery); err != nil {
+			b.Fatal("unexpected error", err)
+		}
+	}
+}
+
+func BenchmarkUnescapePathLongPath(b *testing.B) {
+	b.SetBytes(int64(len(benchLongPath)))
+	var dst []byte
+	for i := 0; i < b.N; i++ {
+		var err error
+		if dst, err = UnescapePath(dst[:0], benchLongPath); err != nil {
+			b.Fatal("unexpected error", err)
+		}
+	}
+}
+
+func BenchmarkFastURLParseLongPath(b *testing.B) {
+	input := append([]byte("http://www.example.com"), benchLongPath...)
+	input = append(append(input, '?'), benchLongQuery...)
+	b.SetBytes(int64(len(input)))
+	benchmarkFastURLParse(b, input)
+}
+
+func benchmarkFastURLViewParse(b *testing.B, url []byte) {
+	var v FastURLView
+	for i := 0; i < b.N; i++ {
+		err := v.Parse(url)
+		if err != nil {
+			b.Fatal("unexpected error", err)
+		}
+	}
+}
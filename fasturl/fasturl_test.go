@@ -77,6 +77,76 @@ func TestFastURLQuery(t *testing.T) {
 	require.Equal(t, [][]byte{[]byte("1"), []byte("2")}, values)
 }
 
+func TestFastURLEncodeRawQuery(t *testing.T) {
+	var f FastURL
+	require.Nil(t, f.Parse([]byte("http://x/?a=1&b=%20c&d")))
+	require.Equal(t, "http://x/?a=1&b=%20c&d", string(f.Encode(nil)))
+
+	// Reading the query keeps the raw query
+	q := f.GetQuery()
+	_, ok := q.Get("a")
+	require.True(t, ok)
+	var s struct {
+		A int `query:"a"`
+	}
+	require.Nil(t, UnmarshalQuery(q, &s))
+	require.Equal(t, "http://x/?a=1&b=%20c&d", string(f.Encode(nil)))
+
+	// The modified query is encoded
+	q.Set("a", "2")
+	require.Equal(t, "http://x/?a=2&b=+c&d=", string(f.Encode(nil)))
+	q.Reset()
+	require.Equal(t, "http://x/", string(f.Encode(nil)))
+
+	// Parse starts over with the raw query
+	f.Reset()
+	require.Nil(t, f.Parse([]byte("http://x/?c=%7E")))
+	f.GetQuery().Sort()
+	require.Equal(t, "http://x/?c=~", string(f.Encode(nil)))
+	f.Reset()
+	require.Nil(t, f.Parse([]byte("http://x/?c=%7E")))
+	f.GetQuery()
+	require.Equal(t, "http://x/?c=%7E", string(f.Encode(nil)))
+}
+
+func TestFastURLEncodeEscape(t *testing.T) {
+	for _, tt := range []struct {
+		base string
+		set  func(f *FastURL)
+		href string
+	}{
+		{"http://x/", func(f *FastURL) { f.SetUser("a@b") }, "http://a%40b:@x/"},
+		{"http://x/", func(f *FastURL) { f.SetUser("a:b"); f.SetPass("c@d/e") }, "http://a%3Ab:c%40d%2Fe@x/"},
+		{"http://x/", func(f *FastURL) { f.SetPass("100%") }, "http://:100%@x/"},
+		{"http://x/", func(f *FastURL) { f.SetPathname("/a b?c") }, "http://x/a%20b%3Fc"},
+		{"http://x/", func(f *FastURL) { f.SetPathname("/a%20b#c") }, "http://x/a%20b%23c"},
+		{"http://x/", func(f *FastURL) { f.SetPathname("/a\\b") }, "http://x/a%5Cb"},
+		{"http://x/", func(f *FastURL) { f.SetPathname("/a%zz%7 b") }, "http://x/a%zz%7%20b"},
+		{"http://x/%zz?a#%7", func(f *FastURL) {}, "http://x/%zz?a#%7"},
+		{"http://x/", func(f *FastURL) { f.SetPathname("a") }, "http://x/a"},
+		{"http://x/", func(f *FastURL) { f.SetPathname("") }, "http://x/"},
+		{"http://x/", func(f *FastURL) { f.SetPathname("/\u00e9") }, "http://x/%C3%A9"},
+		{"http://x/", func(f *FastURL) { f.SetPathname("/a!b(c)") }, "http://x/a!b(c)"},
+		{"http://x/", func(f *FastURL) { f.SetHash("a#b c") }, "http://x/#a%23b%20c"},
+		{"http://x/", func(f *FastURL) { f.SetHash("#a") }, "http://x/#a"},
+		{"foo:/a", func(f *FastURL) { f.SetPathname("//b") }, "foo:/.//b"},
+		{"foo:///a", func(f *FastURL) {}, "foo:///a"},
+		{"foo://x/a", func(f *FastURL) { f.SetPathname("/a\\b") }, "foo://x/a\\b"},
+		{"mailto:a", func(f *FastURL) { f.SetPathname("b?c#d e") }, "mailto:b%3Fc%23d e"},
+	} {
+		var f FastURL
+		require.Nil(t, f.Parse([]byte(tt.base)), tt.base)
+		tt.set(&f)
+		href := string(f.Encode(nil))
+		require.Equal(t, tt.href, href, tt.base)
+
+		// The url parses back to itself
+		var g FastURL
+		require.Nil(t, g.Parse([]byte(href)), href)
+		require.Equal(t, href, string(g.Encode(nil)), href)
+	}
+}
+
 func TestFastURLNormlizePathname(t *testing.T) {
 	for _, tt := range []struct {
 		Input  string
@@ -173,15 +243,8 @@ func TestFastURLParse(t *testing.T) {
 			hash:     "",
 		},
 		T{
-			input:    "git+ssh://git@github.com:npm/npm",
-			protocol: "git+ssh",
-			slashes:  true,
-			auth:     "git",
-			user:     "git",
-			host:     "github.com:npm",
-			port:     "",
-			hostname: "github.com",
-			pathname: "/npm",
+			input:  "git+ssh://git@github.com:npm/npm",
+			haserr: true,
 		},
 		T{
 			input:    "http://a@b?@c",
@@ -225,6 +288,32 @@ func TestFastURLParse(t *testing.T) {
 			query:    "baz=quux",
 			pathname: "/foo/bar",
 		},
+		T{
+			input:    "http://a/b@c",
+			protocol: "http",
+			host:     "a",
+			hostname: "a",
+			pathname: "/b@c",
+		},
+		T{
+			input:    "http://a@b@c/",
+			protocol: "http",
+			auth:     "a@b",
+			user:     "a%40b",
+			host:     "c",
+			hostname: "c",
+			pathname: "/",
+		},
+		T{
+			input:    "http://us er:p:w@c/",
+			protocol: "http",
+			auth:     "us er:p:w",
+			user:     "us%20er",
+			pass:     "p%3Aw",
+			host:     "c",
+			hostname: "c",
+			pathname: "/",
+		},
 		T{
 			input:    "http://www.google.com:443",
 			protocol: "http",
@@ -259,3 +348,309 @@ func TestFastURLParse(t *testing.T) {
 		require.Equal(t, tt[i].hash, string(f.GetHash()), input)
 	}
 }
+
+func TestFastURLParseAuthority(t *testing.T) {
+	for _, tt := range []struct {
+		input string
+		user  string
+		host  string
+	}{
+		{"http://a@b\\c@d/", "a", "b"},
+		{"foo://a@b\\c@d/", "a%40b%5Cc", "d"},
+		{"http://a@b/c@d", "a", "b"},
+	} {
+		var f FastURL
+		err := f.Parse([]byte(tt.input))
+		require.Nil(t, err, tt.input)
+		require.Equal(t, tt.user, string(f.GetUser()), tt.input)
+		require.Equal(t, tt.host, string(f.GetHost()), tt.input)
+	}
+}
+
+func TestFastURLParseIPv6(t *testing.T) {
+	for _, tt := range []struct {
+		input       string
+		haserr      bool
+		host        string
+		hostname    string
+		port        string
+		unbracketed string
+		href        string
+	}{
+		{
+			input:       "http://[::1]:8080/",
+			host:        "[::1]:8080",
+			hostname:    "[::1]",
+			port:        "8080",
+			unbracketed: "::1",
+			href:        "http://[::1]:8080/",
+		},
+		{
+			input:       "http://[2001:DB8:0:0:1:0:0:1]/a",
+			host:        "[2001:db8::1:0:0:1]",
+			hostname:    "[2001:db8::1:0:0:1]",
+			unbracketed: "2001:db8::1:0:0:1",
+			href:        "http://[2001:db8::1:0:0:1]/a",
+		},
+		{
+			input:       "http://[0:0:0:0:0:ffff:192.168.0.1]/",
+			host:        "[::ffff:c0a8:1]",
+			hostname:    "[::ffff:c0a8:1]",
+			unbracketed: "::ffff:c0a8:1",
+			href:        "http://[::ffff:c0a8:1]/",
+		},
+		{
+			input:       "http://[1:0:0:2::3:0]/",
+			host:        "[1::2:0:0:3:0]",
+			hostname:    "[1::2:0:0:3:0]",
+			unbracketed: "1::2:0:0:3:0",
+			href:        "http://[1::2:0:0:3:0]/",
+		},
+		{
+			input:       "http://[fe80::1%25en0]:81/",
+			host:        "[fe80::1%25en0]:81",
+			hostname:    "[fe80::1%25en0]",
+			port:        "81",
+			unbracketed: "fe80::1%en0",
+			href:        "http://[fe80::1%25en0]:81/",
+		},
+		{input: "http://[::1/", haserr: true},
+		{input: "http://[::1]x/", haserr: true},
+		{input: "http://[1:2:3:4:5:6:7:8:9]/", haserr: true},
+		{input: "http://[1::2::3]/", haserr: true},
+		{input: "http://[::1.2.3.256]/", haserr: true},
+		{input: "http://[::1%25]/", haserr: true},
+		{input: "http://[::1%25a%]/", haserr: true},
+	} {
+		var f FastURL
+		err := f.Parse([]byte(tt.input))
+		if tt.haserr {
+			require.NotNil(t, err, tt.input)
+			continue
+		}
+		require.Nil(t, err, tt.input)
+		require.Equal(t, tt.host, string(f.GetHost()), tt.input)
+		require.Equal(t, tt.hostname, string(f.GetHostname()), tt.input)
+		require.Equal(t, tt.port, string(f.GetPort()), tt.input)
+		require.Equal(t, tt.unbracketed, string(f.GetHostnameUnbracketed()), tt.input)
+		require.Equal(t, tt.href, string(f.Encode(nil)), tt.input)
+	}
+
+	var f FastURL
+	f.SetProtocol("http")
+	f.SetHostname("::1")
+	f.SetPathname("/")
+	require.Equal(t, "http://[::1]/", string(f.Encode(nil)))
+}
+
+func TestFastURLParseIPv4(t *testing.T) {
+	for _, tt := range []struct {
+		input    string
+		haserr   bool
+		host     string
+		hostname string
+		hostType HostType
+	}{
+		{input: "http://0x7f.1/", host: "127.0.0.1", hostname: "127.0.0.1", hostType: HostTypeIPv4},
+		{input: "http://2130706433/", host: "127.0.0.1", hostname: "127.0.0.1", hostType: HostTypeIPv4},
+		{input: "http://127.1:8080/", host: "127.0.0.1:8080", hostname: "127.0.0.1", hostType: HostTypeIPv4},
+		{input: "http://0177.0.0.01/", host: "127.0.0.1", hostname: "127.0.0.1", hostType: HostTypeIPv4},
+		{input: "http://0X7F.0x0.0.0x1./", host: "127.0.0.1", hostname: "127.0.0.1", hostType: HostTypeIPv4},
+		{input: "http://192.168.0.257/", haserr: true},
+		{input: "http://256.0.0.1/", haserr: true},
+		{input: "http://4294967296/", haserr: true},
+		{input: "http://1.2.3.4.5/", haserr: true},
+		{input: "http://1.2.3.09/", haserr: true},
+		{input: "http://1..2/", haserr: true},
+		{input: "http://0x100000000/", haserr: true},
+		{input: "http://1.2.3.4x/", host: "1.2.3.4x", hostname: "1.2.3.4x", hostType: HostTypeDomain},
+		{input: "http://example.com/", host: "example.com", hostname: "example.com", hostType: HostTypeDomain},
+		{input: "//0x7f.1/", host: "127.0.0.1", hostname: "127.0.0.1", hostType: HostTypeIPv4},
+		{input: "foo://0x7f.1/", host: "0x7f.1", hostname: "0x7f.1", hostType: HostTypeOpaque},
+		{input: "http://[::1]/", host: "[::1]", hostname: "[::1]", hostType: HostTypeIPv6},
+		{input: "file:///etc/hosts", hostType: HostTypeNone},
+	} {
+		var f FastURL
+		err := f.Parse([]byte(tt.input))
+		if tt.haserr {
+			require.NotNil(t, err, tt.input)
+			continue
+		}
+		require.Nil(t, err, tt.input)
+		require.Equal(t, tt.host, string(f.GetHost()), tt.input)
+		require.Equal(t, tt.hostname, string(f.GetHostname()), tt.input)
+		require.Equal(t, tt.hostType, f.GetHostType(), tt.input)
+	}
+}
+
+func TestFastURLParseDomain(t *testing.T) {
+	for _, tt := range []struct {
+		input    string
+		haserr   bool
+		hostname string
+		hostType HostType
+	}{
+		{input: "http://%41/", hostname: "a", hostType: HostTypeDomain},
+		{input: "http://ex%61mple.COM/", hostname: "example.com", hostType: HostTypeDomain},
+		{input: "http://%31%32%37.0.0.1/", hostname: "127.0.0.1", hostType: HostTypeIPv4},
+		{input: "http://m%C3%BCnchen.de/", hostname: "münchen.de", hostType: HostTypeDomain},
+		{input: "http://a b/", haserr: true},
+		{input: "http://a<b/", haserr: true},
+		{input: "http://a^b/", haserr: true},
+		{input: "http://a%25b/", haserr: true},
+		{input: "http://a%00b/", haserr: true},
+		{input: "http://a%2Fb/", haserr: true},
+		{input: "foo://a%2Fb/", hostname: "a%2fb", hostType: HostTypeOpaque},
+		{input: "foo://a b/", haserr: true},
+		{input: "foo://a<b/", haserr: true},
+	} {
+		var f FastURL
+		err := f.Parse([]byte(tt.input))
+		if tt.haserr {
+			require.NotNil(t, err, tt.input)
+			continue
+		}
+		require.Nil(t, err, tt.input)
+		require.Equal(t, tt.hostname, string(f.GetHostname()), tt.input)
+		require.Equal(t, tt.hostType, f.GetHostType(), tt.input)
+	}
+}
+
+func TestFastURLSetHostname(t *testing.T) {
+	var f FastURL
+	f.SetHostname("münchen.de")
+	require.Equal(t, HostTypeDomain, f.GetHostType())
+	d, err := f.AppendHostnameASCII(nil)
+	require.Nil(t, err)
+	require.Equal(t, "xn--mnchen-3ya.de", string(d))
+
+	require.Nil(t, f.Parse([]byte("http://1.2.3.4:8080/")))
+	require.Equal(t, HostTypeIPv4, f.GetHostType())
+	f.SetHostname("münchen.de")
+	require.Equal(t, HostTypeDomain, f.GetHostType())
+	require.Equal(t, "münchen.de:8080", string(f.GetHost()))
+	d, err = f.AppendHostnameASCII(nil)
+	require.Nil(t, err)
+	require.Equal(t, "xn--mnchen-3ya.de", string(d))
+
+	f.SetHostname("10.0.0.1")
+	require.Equal(t, HostTypeIPv4, f.GetHostType())
+	f.SetHostname("::1")
+	require.Equal(t, HostTypeIPv6, f.GetHostType())
+	f.SetHostname("[::1]")
+	require.Equal(t, HostTypeIPv6, f.GetHostType())
+	f.SetHostname("")
+	require.Equal(t, HostTypeNone, f.GetHostType())
+
+	f.SetHostname("example.com")
+	f.SetProtocol("foo")
+	require.Equal(t, HostTypeOpaque, f.GetHostType())
+	f.SetProtocol("https")
+	require.Equal(t, HostTypeDomain, f.GetHostType())
+}
+
+func TestFastURLParsePort(t *testing.T) {
+	for _, tt := range []struct {
+		input  string
+		haserr bool
+		kind   ErrorKind
+		offset int
+		host   string
+		port   string
+		number uint16
+	}{
+		{input: "http://x:8080/", host: "x:8080", port: "8080", number: 8080},
+		{input: "http://x:0080/", host: "x", port: ""},
+		{input: "http://x:08080/", host: "x:8080", port: "8080", number: 8080},
+		{input: "http://x:0000/", host: "x:0", port: "0", number: 0},
+		{input: "http://x:65535/", host: "x:65535", port: "65535", number: 65535},
+		{input: "http://x:/", host: "x", port: ""},
+		{input: "http://[::1]:0443/", host: "[::1]:443", port: "443", number: 443},
+		{input: "foo://x:00021/", host: "x:21", port: "21", number: 21},
+		{input: "http://x:abc/", haserr: true, kind: ErrorKindInvalidPort, offset: 9},
+		{input: "http://x:8a/", haserr: true, kind: ErrorKindInvalidPort, offset: 10},
+		{input: "http://x:-1/", haserr: true, kind: ErrorKindInvalidPort, offset: 9},
+		{input: "http://x:65536/", haserr: true, kind: ErrorKindPortOutOfRange, offset: 9},
+		{input: "http://x:99999999999999999999/", haserr: true, kind: ErrorKindPortOutOfRange, offset: 9},
+		{input: "http://[::1]:x/", haserr: true, kind: ErrorKindInvalidPort, offset: 13},
+	} {
+		var f FastURL
+		err := f.Parse([]byte(tt.input))
+		if tt.haserr {
+			pe, ok := err.(*ParseError)
+			require.True(t, ok, tt.input)
+			require.Equal(t, ComponentPort, pe.Component, tt.input)
+			require.Equal(t, tt.kind, pe.Kind, tt.input)
+			require.Equal(t, tt.offset, pe.Offset, tt.input)
+			continue
+		}
+		require.Nil(t, err, tt.input)
+		require.Equal(t, tt.host, string(f.GetHost()), tt.input)
+		require.Equal(t, tt.port, string(f.GetPort()), tt.input)
+		n, ok := f.GetPortNumber()
+		require.Equal(t, tt.port != "", ok, tt.input)
+		require.Equal(t, tt.number, n, tt.input)
+	}
+}
+
+func TestFastURLSetPortNumber(t *testing.T) {
+	var f FastURL
+	err := f.Parse([]byte("http://example.com:8080/a"))
+	require.Nil(t, err)
+
+	f.SetPortNumber(9090)
+	require.Equal(t, "9090", string(f.GetPort()))
+	require.Equal(t, "example.com:9090", string(f.GetHost()))
+	require.Equal(t, "http://example.com:9090/a", string(f.Encode(nil)))
+
+	f.SetPortNumber(80)
+	require.Equal(t, "", string(f.GetPort()))
+	require.Equal(t, "example.com", string(f.GetHost()))
+	_, ok := f.GetPortNumber()
+	require.False(t, ok)
+
+	f.SetPortNumber(0)
+	require.Equal(t, "example.com:0", string(f.GetHost()))
+
+	f.SetHostname("[::1]")
+	require.Equal(t, "[::1]:0", string(f.GetHost()))
+	require.Equal(t, "http://[::1]:0/a", string(f.Encode(nil)))
+}
+
+func TestFastURLParseOpaque(t *testing.T) {
+	for _, tt := range []struct {
+		input    string
+		opaque   bool
+		protocol string
+		user     string
+		host     string
+		pathname string
+	}{
+		{input: "mailto:user@example.com", opaque: true, protocol: "mailto", pathname: "user@example.com"},
+		{input: "urn:isbn:123", opaque: true, protocol: "urn", pathname: "isbn:123"},
+		{input: "javascript:alert(1)", opaque: true, protocol: "javascript", pathname: "alert(1)"},
+		{input: "data:text/plain,a b", opaque: true, protocol: "data", pathname: "text/plain,a b"},
+		{input: "about:", opaque: true, protocol: "about", pathname: ""},
+		{input: "foo:/a@b/c", protocol: "foo", pathname: "/a@b/c"},
+		{input: "foo://a@b/c", protocol: "foo", user: "a", host: "b", pathname: "/c"},
+		{input: "http:a@b/c", protocol: "http", user: "a", host: "b", pathname: "/c"},
+	} {
+		var f FastURL
+		err := f.Parse([]byte(tt.input))
+		require.Nil(t, err, tt.input)
+		require.Equal(t, tt.opaque, f.IsOpaque(), tt.input)
+		require.Equal(t, tt.protocol, string(f.GetProtocol()), tt.input)
+		require.Equal(t, tt.user, string(f.GetUser()), tt.input)
+		require.Equal(t, tt.host, string(f.GetHost()), tt.input)
+		require.Equal(t, tt.pathname, string(f.GetPathname()), tt.input)
+		if tt.opaque {
+			require.Equal(t, tt.input, string(f.Encode(nil)), tt.input)
+		}
+	}
+
+	var f FastURL
+	input := "mailto:user@example.com?subject=hi#x"
+	require.Nil(t, f.Parse([]byte(input)))
+	f.GetQuery()
+	require.Equal(t, input, string(f.Encode(nil)))
+}
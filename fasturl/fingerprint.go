@@ -0,0 +1,237 @@
+package fasturl
+
+import (
+	"bytes"
+	"math/bits"
+)
+
+// FingerprintOptions controls the components which are hashed by Fingerprint.
+type FingerprintOptions struct {
+	// IgnoreFragment ignores the hash.
+	IgnoreFragment bool
+	// SortQuery makes the fingerprint independent of the order of the query
+	// pairs, like a=1&b=2 and b=2&a=1.
+	SortQuery bool
+	// DropParams ignores the query pairs with these names, like utm_source.
+	DropParams []string
+}
+
+// Hash128 is the 128-bit fingerprint.
+type Hash128 struct {
+	Hi uint64
+	Lo uint64
+}
+
+// Fingerprint hashes the canonicalized components of the url with 128-bit
+// FNV-1a. The protocol and hostname are lowercased, the default port is
+// ignored, the %xx is normalized like Canonicalize and the dot segments of
+// the path are removed, so the equivalent urls have the same fingerprint.
+//
+// The fingerprint is stable, it can be stored.
+func Fingerprint(f *FastURL, opts FingerprintOptions) Hash128 {
+	h := newFNV128a()
+
+	h.writeLower(f.protocol)
+	h.writeByte(0)
+
+	h.writeNormalized(f.user)
+	h.writeByte(0)
+	h.writeNormalized(f.pass)
+	h.writeByte(0)
+
+	h.writeLower(f.hostname)
+	h.writeByte(0)
+	if len(f.port) > 0 && (f.scheme == nil || string(f.port) != f.scheme.DefaultPort) {
+		h.write(f.port)
+	}
+	h.writeByte(0)
+
+	if f.opaque {
+		h.writeNormalized(f.pathname)
+	} else {
+		h.writePath(f.pathname)
+	}
+	h.writeByte(0)
+
+	var sum Hash128
+	f.GetQuery().Range(func(name, value []byte) bool {
+		for _, p := range opts.DropParams {
+			if string(name) == p {
+				return true
+			}
+		}
+
+		if !opts.SortQuery {
+			h.writeBytes(name)
+			h.writeBytes(value)
+			return true
+		}
+
+		// The sum of the pairs does not depend on the order
+		ph := newFNV128a()
+		ph.writeBytes(name)
+		ph.writeBytes(value)
+		var carry uint64
+		sum.Lo, carry = bits.Add64(sum.Lo, ph.lo, 0)
+		sum.Hi, _ = bits.Add64(sum.Hi, ph.hi, carry)
+		return true
+	})
+	if opts.SortQuery {
+		h.writeUint64(sum.Hi)
+		h.writeUint64(sum.Lo)
+	}
+	h.writeByte(0)
+
+	if !opts.IgnoreFragment {
+		h.writeNormalized(f.hash)
+	}
+
+	return Hash128{Hi: h.hi, Lo: h.lo}
+}
+
+// Fingerprint64 folds the 128-bit fingerprint to 64 bits.
+func Fingerprint64(f *FastURL, opts FingerprintOptions) uint64 {
+	h := Fingerprint(f, opts)
+	return h.Hi ^ h.Lo
+}
+
+// fnv128a is the 128-bit FNV-1a hash.
+//
+// More detail see http://www.isthe.com/chongo/tech/comp/fnv/index.html
+type fnv128a struct {
+	hi uint64
+	lo uint64
+}
+
+const (
+	fnv128OffsetHi = 0x6c62272e07bb0142
+	fnv128OffsetLo = 0x62b821756295c58d
+	// The prime is 2^88 + 2^8 + 0x3b
+	fnv128PrimeShift = 24
+	fnv128PrimeLo    = 0x13b
+)
+
+func newFNV128a() fnv128a {
+	return fnv128a{hi: fnv128OffsetHi, lo: fnv128OffsetLo}
+}
+
+func (h *fnv128a) writeByte(c byte) {
+	h.lo ^= uint64(c)
+	// (hi, lo) * prime mod 2^128
+	hi, lo := bits.Mul64(h.lo, fnv128PrimeLo)
+	hi += h.hi*fnv128PrimeLo + h.lo<<fnv128PrimeShift
+	h.hi, h.lo = hi, lo
+}
+
+func (h *fnv128a) write(b []byte) {
+	for i := 0; i < len(b); i++ {
+		h.writeByte(b[i])
+	}
+}
+
+func (h *fnv128a) writeUint64(v uint64) {
+	for i := uint(0); i < 64; i += 8 {
+		h.writeByte(byte(v >> i))
+	}
+}
+
+// writeBytes writes b with its length, so b may contain any byte.
+func (h *fnv128a) writeBytes(b []byte) {
+	h.writeUint64(uint64(len(b)))
+	h.write(b)
+}
+
+func (h *fnv128a) writeLower(b []byte) {
+	for i := 0; i < len(b); i++ {
+		c := b[i]
+		if c >= 'A' && c <= 'Z' {
+			c += 'a' - 'A'
+		}
+		h.writeByte(c)
+	}
+}
+
+// writeNormalized writes b like normalizePercentEncoding with upper and
+// decode.
+func (h *fnv128a) writeNormalized(b []byte) {
+	const hex = "0123456789ABCDEF"
+
+	for i := 0; i < len(b); i++ {
+		c := b[i]
+		if c != '%' || i+2 >= len(b) || !ishex(b[i+1]) || !ishex(b[i+2]) {
+			h.writeByte(c)
+			continue
+		}
+
+		v := unhex(b[i+1])<<4 | unhex(b[i+2])
+		if isUnreserved(v) {
+			h.writeByte(v)
+		} else {
+			h.writeByte('%')
+			h.writeByte(hex[v>>4])
+			h.writeByte(hex[v&15])
+		}
+		i += 2
+	}
+}
+
+// writePath writes the segments of the absolute path p without the dot
+// segments. The segments are written from the last one, a ".." skips the
+// segment before it like removeDotSegments, so no buffer is needed.
+func (h *fnv128a) writePath(p []byte) {
+	if len(p) == 0 {
+		// The empty path is "/"
+		h.writeByte('/')
+		return
+	}
+
+	skip := 0
+	last := true
+	for end := len(p); end > 0 || last; {
+		start := bytes.LastIndexByte(p[:end], '/') + 1
+		seg := p[start:end]
+		switch dotSegment(seg) {
+		case 1:
+			if last {
+				h.writeByte('/')
+			}
+		case 2:
+			if last {
+				h.writeByte('/')
+			}
+			skip++
+		default:
+			if skip > 0 {
+				skip--
+			} else {
+				h.writeNormalized(seg)
+				h.writeByte('/')
+			}
+		}
+		last = false
+		end = start - 1
+		if start == 0 {
+			break
+		}
+	}
+}
+
+// dotSegment returns 1 for ".", 2 for ".." and 0 for the other segments,
+// the '.' may be encoded as %2e.
+func dotSegment(seg []byte) int {
+	n := 0
+	for i := 0; i < len(seg); i++ {
+		switch {
+		case seg[i] == '.':
+		case seg[i] == '%' && i+2 < len(seg) && seg[i+1] == '2' && (seg[i+2] == 'e' || seg[i+2] == 'E'):
+			i += 2
+		default:
+			return 0
+		}
+		n++
+		if n > 2 {
+			return 0
+		}
+	}
+	return n
+}
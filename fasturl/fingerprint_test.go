@@ -0,0 +1,88 @@
+package fasturl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func fingerprint(t *testing.T, input string, opts FingerprintOptions) Hash128 {
+	var f FastURL
+	require.Nil(t, f.Parse([]byte(input)), input)
+	return Fingerprint(&f, opts)
+}
+
+func TestFingerprint(t *testing.T) {
+	for _, tt := range []struct {
+		a, b  string
+		opts  FingerprintOptions
+		equal bool
+	}{
+		{a: "http://example.com/a", b: "HTTP://EXAMPLE.com/a", equal: true},
+		{a: "http://example.com/a", b: "http://example.com:80/a", equal: true},
+		{a: "http://example.com", b: "http://example.com/", equal: true},
+		{a: "http://example.com/~a/%3A", b: "http://example.com/%7ea/%3a", equal: true},
+		{a: "http://example.com/a/b/../c/./d", b: "http://example.com/a/c/d", equal: true},
+		{a: "http://example.com/a/b/..", b: "http://example.com/a/", equal: true},
+		{a: "http://example.com/a/b/%2E%2e/c", b: "http://example.com/a/c", equal: true},
+		{a: "http://example.com/../../a", b: "http://example.com/a", equal: true},
+		{a: "http://example.com/a?x=1&y=2", b: "http://example.com/a?x=%31&y=2", equal: true},
+		{a: "http://example.com/a#s", b: "http://example.com/a#t", opts: FingerprintOptions{IgnoreFragment: true}, equal: true},
+		{a: "http://example.com/a?x=1&y=2", b: "http://example.com/a?y=2&x=1", opts: FingerprintOptions{SortQuery: true}, equal: true},
+		{
+			a:     "http://example.com/a?utm_source=x&x=1&fbclid=y",
+			b:     "http://example.com/a?x=1",
+			opts:  FingerprintOptions{DropParams: []string{"utm_source", "fbclid"}},
+			equal: true,
+		},
+		{a: "http://example.com/a", b: "https://example.com/a"},
+		{a: "http://example.com/a", b: "http://example.com:8080/a"},
+		{a: "http://example.com/a", b: "http://example.com/A"},
+		{a: "http://example.com/a", b: "http://example.com/a/"},
+		{a: "http://example.com/a%2Fb", b: "http://example.com/a/b"},
+		{a: "http://example.com/ab", b: "http://example.com/a/b"},
+		{a: "http://u@example.com/a", b: "http://example.com/a"},
+		{a: "http://u:@example.com/a", b: "http://:u@example.com/a"},
+		{a: "http://example.com/a#s", b: "http://example.com/a#t"},
+		{a: "http://example.com/a#s", b: "http://example.com/a"},
+		{a: "http://example.com/a?x=1&y=2", b: "http://example.com/a?y=2&x=1"},
+		{a: "http://example.com/a?x=1&x=1", b: "http://example.com/a?x=1", opts: FingerprintOptions{SortQuery: true}},
+		{a: "http://example.com/a?x=12", b: "http://example.com/a?x1=2"},
+		{a: "http://example.com/a?x=12", b: "http://example.com/a?x1=2", opts: FingerprintOptions{SortQuery: true}},
+		{a: "http://example.com/a?b", b: "http://example.com/a/b"},
+	} {
+		a := fingerprint(t, tt.a, tt.opts)
+		b := fingerprint(t, tt.b, tt.opts)
+		if tt.equal {
+			require.Equal(t, a, b, tt.a+" "+tt.b)
+		} else {
+			require.NotEqual(t, a, b, tt.a+" "+tt.b)
+		}
+	}
+}
+
+func TestFingerprintStable(t *testing.T) {
+	// The fingerprint is stored, it must not change
+	h := fingerprint(t, "https://example.com/a?x=1#s", FingerprintOptions{})
+	require.Equal(t, Hash128{Hi: 0x40083937f7554bcf, Lo: 0xe4c2984e6c49165e}, h)
+
+	var f FastURL
+	require.Nil(t, f.Parse([]byte("https://example.com/a?x=1#s")))
+	require.Equal(t, h.Hi^h.Lo, Fingerprint64(&f, FingerprintOptions{}))
+}
+
+func TestFNV128a(t *testing.T) {
+	// The test vectors of the FNV-1a 128-bit
+	for _, tt := range []struct {
+		input  string
+		expect Hash128
+	}{
+		{"", Hash128{Hi: 0x6c62272e07bb0142, Lo: 0x62b821756295c58d}},
+		{"a", Hash128{Hi: 0xd228cb696f1a8caf, Lo: 0x78912b704e4a8964}},
+		{"foobar", Hash128{Hi: 0x343e1662793c64bf, Lo: 0x6f0d3597ba446f18}},
+	} {
+		h := newFNV128a()
+		h.write([]byte(tt.input))
+		require.Equal(t, tt.expect, Hash128{Hi: h.hi, Lo: h.lo}, tt.input)
+	}
+}
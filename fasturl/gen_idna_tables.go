@@ -0,0 +1,462 @@
+//go:build ignore
+// +build ignore
+
+// Generates idna_tables.go.
+//
+// The tables are derived from the Unicode Character Database files of the
+// version below, so the processing does not depend on the Unicode version of
+// the Go toolchain:
+//
+//	IdnaMappingTable.txt             the UTS #46 status and mapping
+//	UnicodeData.txt                  the marks, the combining classes, the Bidi
+//	                                 classes and the canonical decompositions
+//	CompositionExclusions.txt        the exclusions from the NFC composition
+//	extracted/DerivedJoiningType.txt the joining types of CheckJoiners
+//
+// The files are read from the directory given by -ucd, or else downloaded
+// from unicode.org.
+//
+// Usage: go run gen_idna_tables.go [-ucd dir] [-output idna_tables.go]
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const unicodeVersion = "17.0.0"
+
+const maxRune = 0x10FFFF
+
+var files = map[string]string{
+	"IdnaMappingTable.txt":      "https://www.unicode.org/Public/idna/%s/IdnaMappingTable.txt",
+	"UnicodeData.txt":           "https://www.unicode.org/Public/%s/ucd/UnicodeData.txt",
+	"CompositionExclusions.txt": "https://www.unicode.org/Public/%s/ucd/CompositionExclusions.txt",
+	"DerivedJoiningType.txt":    "https://www.unicode.org/Public/%s/ucd/extracted/DerivedJoiningType.txt",
+}
+
+// bidiClasses are the Bidi classes of CheckBidi, the other classes fail
+// every rule. L is the default and is not listed in the table.
+var bidiClasses = map[string]string{
+	"R":   "bidiR",
+	"AL":  "bidiAL",
+	"AN":  "bidiAN",
+	"EN":  "bidiEN",
+	"ES":  "bidiES",
+	"CS":  "bidiCS",
+	"ET":  "bidiET",
+	"ON":  "bidiON",
+	"BN":  "bidiBN",
+	"NSM": "bidiNSM",
+}
+
+var joiningTypes = map[string]string{
+	"L": "joiningL",
+	"D": "joiningD",
+	"R": "joiningR",
+	"T": "joiningT",
+}
+
+var (
+	ucd    = flag.String("ucd", "", "the directory of the UCD files, downloaded from unicode.org if empty")
+	output = flag.String("output", "idna_tables.go", "the output file")
+)
+
+// char is a code point of UnicodeData.txt.
+type char struct {
+	category  string
+	ccc       int
+	bidi      string
+	decompose []rune
+}
+
+func main() {
+	flag.Parse()
+
+	status, mapping := parseIDNA()
+	data := parseUnicodeData()
+	excl := parseExclusions()
+	joining := parseJoiningTypes()
+
+	valid := func(r rune) bool {
+		return status[r] == "valid" || status[r] == "deviation"
+	}
+
+	type entry struct {
+		lo, hi rune
+		stride rune
+		delta  rune
+		to     []rune
+	}
+	var entries []entry
+	for r := rune(0x80); r <= maxRune; r++ {
+		m, ok := mapping[r]
+		if !ok {
+			continue
+		}
+		if len(m) != 1 {
+			entries = append(entries, entry{r, r, 0, 0, m})
+			continue
+		}
+		delta := m[0] - r
+		if n := len(entries); n > 0 {
+			e := &entries[n-1]
+			step := r - e.hi
+			if e.to == nil && e.delta == delta && (step == 1 || step == 2) && (e.stride == 0 || e.stride == step) {
+				e.hi, e.stride = r, step
+				continue
+			}
+		}
+		entries = append(entries, entry{r, r, 0, delta, nil})
+	}
+
+	disallowed := map[rune]string{}
+	for r := rune(0x80); r <= maxRune; r++ {
+		if status[r] == "disallowed" {
+			disallowed[r] = "0"
+		}
+	}
+	marks := map[rune]string{}
+	bidi := map[rune]string{}
+	ccc := map[rune]string{}
+	for r, c := range data {
+		if strings.HasPrefix(c.category, "M") {
+			marks[r] = "0"
+		}
+		if c.bidi != "L" {
+			if v, ok := bidiClasses[c.bidi]; ok {
+				bidi[r] = v
+			} else {
+				bidi[r] = "bidiOther"
+			}
+		}
+		if c.ccc != 0 {
+			ccc[r] = strconv.Itoa(c.ccc)
+		}
+	}
+	joins := map[rune]string{}
+	for r, t := range joining {
+		joins[r] = joiningTypes[t]
+	}
+
+	cps := make([]rune, 0, len(data))
+	for r := range data {
+		cps = append(cps, r)
+	}
+	sort.Slice(cps, func(i, j int) bool { return cps[i] < cps[j] })
+
+	type decomposition struct {
+		r  rune
+		to []rune
+	}
+	var decomps []decomposition
+	var comps [][3]rune
+	for _, r := range cps {
+		d := data[r].decompose
+		if d == nil {
+			continue
+		}
+		decomps = append(decomps, decomposition{r, fullDecomposition(data, r, nil)})
+		if len(d) == 2 && !excl[r] && data[r].ccc == 0 && data[d[0]].ccc == 0 {
+			comps = append(comps, [3]rune{d[0], d[1], r})
+		}
+	}
+	sort.Slice(comps, func(i, j int) bool {
+		a, b := comps[i], comps[j]
+		if a[0] != b[0] {
+			return a[0] < b[0]
+		}
+		if a[1] != b[1] {
+			return a[1] < b[1]
+		}
+		return a[2] < b[2]
+	})
+	seconds := map[rune]string{}
+	for _, c := range comps {
+		seconds[c[1]] = "0"
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintln(&b, "// Code generated by gen_idna_tables.go; DO NOT EDIT.")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "package fasturl")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "// idnaUnicodeVersion is the version of Unicode the tables are derived from.")
+	fmt.Fprintf(&b, "const idnaUnicodeVersion = %q\n", unicodeVersion)
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "// idnaMappings are the mapped and ignored code points.")
+	fmt.Fprintln(&b, "var idnaMappings = [...]idnaMapping{")
+	for _, e := range entries {
+		if e.to == nil {
+			stride := e.stride
+			if stride == 0 {
+				stride = 1
+			}
+			fmt.Fprintf(&b, "\t{0x%04X, 0x%04X, %d, %d, \"\"},\n", e.lo, e.hi, stride, e.delta)
+		} else {
+			fmt.Fprintf(&b, "\t{0x%04X, 0x%04X, 0, 0, %s},\n", e.lo, e.hi, goString(e.to))
+		}
+	}
+	fmt.Fprintln(&b, "}")
+	printRanges(&b, "idnaDisallowed", "are the disallowed code points.", ranges(disallowed, nil))
+	printRanges(&b, "idnaMarks", "are the marks, General_Category=M.", ranges(marks, valid))
+	printRanges(&b, "idnaJoiningTypes", "are the joining types other than U.", ranges(joins, valid))
+	printRanges(&b, "idnaBidiClasses", "are the Bidi classes other than L.", ranges(bidi, valid))
+	printRanges(&b, "idnaCombiningClasses", "are the non-zero canonical combining classes.", ranges(ccc, nil))
+	printRanges(&b, "idnaCompositionSeconds", "are the second code points of the compositions.", ranges(seconds, nil))
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "// idnaDecompositions are the full canonical decompositions.")
+	fmt.Fprintln(&b, "var idnaDecompositions = [...]idnaDecomposition{")
+	for _, d := range decomps {
+		fmt.Fprintf(&b, "\t{0x%04X, %s},\n", d.r, goString(d.to))
+	}
+	fmt.Fprintln(&b, "}")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "// idnaCompositions are the primary composites of NFC by their pairs.")
+	fmt.Fprintln(&b, "var idnaCompositions = [...]idnaComposition{")
+	for _, c := range comps {
+		fmt.Fprintf(&b, "\t{0x%04X, 0x%04X, 0x%04X},\n", c[0], c[1], c[2])
+	}
+	fmt.Fprintln(&b, "}")
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(*output, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// readLines calls fn with the fields of every data line of the UCD file.
+func readLines(name string, fn func(fields []string)) {
+	var r io.Reader
+	if *ucd != "" {
+		f, err := os.Open(filepath.Join(*ucd, name))
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer f.Close()
+		r = f
+	} else {
+		resp, err := http.Get(fmt.Sprintf(files[name], unicodeVersion))
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			log.Fatalf("%s: %s", name, resp.Status)
+		}
+		r = resp.Body
+	}
+
+	s := bufio.NewScanner(r)
+	s.Buffer(nil, 1<<20)
+	for s.Scan() {
+		line := s.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		fields := strings.Split(line, ";")
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+		fn(fields)
+	}
+	if err := s.Err(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func parseRune(s string) rune {
+	r, err := strconv.ParseUint(s, 16, 32)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return rune(r)
+}
+
+// codePoints parses the code point or the range lo..hi.
+func codePoints(field string) (lo, hi rune) {
+	if i := strings.Index(field, ".."); i >= 0 {
+		return parseRune(field[:i]), parseRune(field[i+2:])
+	}
+	r := parseRune(field)
+	return r, r
+}
+
+// parseIDNA returns the status and the mapping of every code point, the
+// STD3 statuses are folded as UseSTD3ASCIIRules is false.
+func parseIDNA() ([]string, map[rune][]rune) {
+	status := make([]string, maxRune+1)
+	for i := range status {
+		status[i] = "disallowed"
+	}
+	mapping := map[rune][]rune{}
+	readLines("IdnaMappingTable.txt", func(fields []string) {
+		s := fields[1]
+		switch s {
+		case "disallowed_STD3_valid":
+			s = "valid"
+		case "disallowed_STD3_mapped":
+			s = "mapped"
+		}
+		to := []rune{}
+		if (s == "mapped" || s == "deviation") && len(fields) > 2 {
+			for _, x := range strings.Fields(fields[2]) {
+				to = append(to, parseRune(x))
+			}
+		}
+		lo, hi := codePoints(fields[0])
+		for r := lo; r <= hi; r++ {
+			status[r] = s
+			switch s {
+			case "mapped":
+				mapping[r] = to
+			case "ignored":
+				mapping[r] = []rune{}
+			}
+		}
+	})
+	return status, mapping
+}
+
+// parseUnicodeData returns the general category, the combining class, the
+// Bidi class and the canonical decomposition of the assigned code points.
+func parseUnicodeData() map[rune]char {
+	data := map[rune]char{}
+	var first rune
+	readLines("UnicodeData.txt", func(fields []string) {
+		r := parseRune(fields[0])
+		ccc, err := strconv.Atoi(fields[3])
+		if err != nil {
+			log.Fatal(err)
+		}
+		c := char{category: fields[2], ccc: ccc, bidi: fields[4]}
+		if fields[5] != "" && !strings.HasPrefix(fields[5], "<") {
+			for _, x := range strings.Fields(fields[5]) {
+				c.decompose = append(c.decompose, parseRune(x))
+			}
+		}
+		switch {
+		case strings.HasSuffix(fields[1], ", First>"):
+			first = r
+		case strings.HasSuffix(fields[1], ", Last>"):
+			for i := first; i <= r; i++ {
+				data[i] = c
+			}
+		default:
+			data[r] = c
+		}
+	})
+	return data
+}
+
+func parseExclusions() map[rune]bool {
+	excl := map[rune]bool{}
+	readLines("CompositionExclusions.txt", func(fields []string) {
+		lo, hi := codePoints(fields[0])
+		for r := lo; r <= hi; r++ {
+			excl[r] = true
+		}
+	})
+	return excl
+}
+
+func parseJoiningTypes() map[rune]string {
+	types := map[rune]string{}
+	readLines("DerivedJoiningType.txt", func(fields []string) {
+		if _, ok := joiningTypes[fields[1]]; !ok {
+			return
+		}
+		lo, hi := codePoints(fields[0])
+		for r := lo; r <= hi; r++ {
+			types[r] = fields[1]
+		}
+	})
+	return types
+}
+
+func fullDecomposition(data map[rune]char, r rune, dst []rune) []rune {
+	d := data[r].decompose
+	if d == nil {
+		return append(dst, r)
+	}
+	for _, x := range d {
+		dst = fullDecomposition(data, x, dst)
+	}
+	return dst
+}
+
+type valueRange struct {
+	lo, hi rune
+	value  string
+}
+
+// ranges merges the code points with the same value into ranges. The code
+// points which are not valid never appear in a label, so they may be
+// swallowed by any range.
+func ranges(values map[rune]string, valid func(r rune) bool) []valueRange {
+	var out []valueRange
+	last, hasLast := "", false
+	for r := rune(0); r <= maxRune; r++ {
+		if valid != nil && !valid(r) {
+			continue
+		}
+		v, ok := values[r]
+		if !ok {
+			hasLast = false
+			continue
+		}
+		if hasLast && last == v {
+			out[len(out)-1].hi = r
+		} else {
+			out = append(out, valueRange{r, r, v})
+		}
+		last, hasLast = v, true
+	}
+	return out
+}
+
+func goString(s []rune) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range s {
+		switch {
+		case 0x20 <= r && r < 0x7F && r != '"' && r != '\\':
+			b.WriteRune(r)
+		case r <= 0xFFFF:
+			fmt.Fprintf(&b, "\\u%04x", r)
+		default:
+			fmt.Fprintf(&b, "\\U%08x", r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+func printRanges(w io.Writer, name, comment string, values []valueRange) {
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "// %s %s\n", name, comment)
+	fmt.Fprintf(w, "var %s = [...]idnaRange{\n", name)
+	for _, v := range values {
+		fmt.Fprintf(w, "\t{0x%04X, 0x%04X, %s},\n", v.lo, v.hi, v.value)
+	}
+	fmt.Fprintln(w, "}")
+}
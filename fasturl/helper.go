@@ -2,6 +2,7 @@ package fasturl
 
 import (
 	"bytes"
+	"encoding/binary"
 	"unsafe"
 )
 
@@ -21,13 +22,152 @@ func toLowercsaeASCII(b []byte) {
 	}
 }
 
-func hasASCIIControl(b []byte) bool {
-	for i := 0; i < len(b); i++ {
-		if b[i] < ' ' || b[i] == 0x7f {
+const (
+	swarOnes  = 0x0101010101010101
+	swarHighs = 0x8080808080808080
+)
+
+// hasASCIIControlGeneric is hasASCIIControl which checks 8 bytes at a time.
+//
+// More detail see https://graphics.stanford.edu/~seander/bithacks.html#HasLessInWord
+func hasASCIIControlGeneric(b []byte) bool {
+	n := len(b)
+	if n < 8 {
+		for i := 0; i < n; i++ {
+			if b[i] < ' ' || b[i] == 0x7f {
+				return true
+			}
+		}
+		return false
+	}
+
+	for i := 0; i < n-8; i += 8 {
+		if hasASCIIControlWord(binary.LittleEndian.Uint64(b[i:])) {
 			return true
 		}
 	}
-	return false
+	// The last word may overlap the checked bytes
+	return hasASCIIControlWord(binary.LittleEndian.Uint64(b[n-8:]))
+}
+
+// hasASCIIControlWord reports whether one of the 8 bytes of x is below 0x20
+// or equal to 0x7f.
+func hasASCIIControlWord(x uint64) bool {
+	lt := (x - swarOnes*0x20) &^ x & swarHighs
+	v := x ^ swarOnes*0x7f
+	eq := (v - swarOnes) &^ v & swarHighs
+	return lt|eq != 0
+}
+
+// isUnreservedWord reports whether the 8 bytes of x are all unreserved:
+//
+//	unreserved = ALPHA / DIGIT / "-" / "." / "_" / "~"
+//
+// The bytes are ASCII, so adding at most 0x80 to a byte never carries into
+// the next one.
+func isUnreservedWord(x uint64) bool {
+	if x&swarHighs != 0 {
+		return false
+	}
+	m := swarInRange(x, '0', '9') | swarInRange(x|swarOnes*0x20, 'a', 'z') |
+		swarEqual(x, '-') | swarEqual(x, '.') | swarEqual(x, '_') | swarEqual(x, '~')
+	return m == swarHighs
+}
+
+// swarInRange sets the high bit of the ASCII bytes of x in [lo, hi].
+func swarInRange(x uint64, lo, hi byte) uint64 {
+	return (x + swarOnes*uint64(0x80-lo)) &^ (x + swarOnes*uint64(0x7f-hi)) & swarHighs
+}
+
+// swarEqual sets the high bit of the ASCII bytes of x equal to c.
+func swarEqual(x uint64, c byte) uint64 {
+	v := x ^ swarOnes*uint64(c)
+	return ^((v&^swarHighs + swarOnes*0x7f) | v) & swarHighs
+}
+
+// PercentEncodeSet is a set of bytes which are percent-encoded by
+// PercentEncode. The sets of WHATWG are predefined, they must not be modified.
+//
+// More detail see https://url.spec.whatwg.org/#percent-encoded-bytes
+type PercentEncodeSet [4]uint64
+
+// Contains reports whether c is in the set.
+func (s *PercentEncodeSet) Contains(c byte) bool {
+	return s[c>>6]&(1<<(c&63)) != 0
+}
+
+// With returns the set with the bytes of chars added.
+func (s PercentEncodeSet) With(chars string) PercentEncodeSet {
+	for i := 0; i < len(chars); i++ {
+		c := chars[i]
+		s[c>>6] |= 1 << (c & 63)
+	}
+	return s
+}
+
+var (
+	// C0ControlPercentEncodeSet is the C0 controls and the bytes above 0x7E.
+	C0ControlPercentEncodeSet = func() PercentEncodeSet {
+		var s PercentEncodeSet
+		for c := 0; c < 256; c++ {
+			if c < 0x20 || c > 0x7e {
+				s[c>>6] |= 1 << (uint(c) & 63)
+			}
+		}
+		return s
+	}()
+	// FragmentPercentEncodeSet is the set of the fragment.
+	FragmentPercentEncodeSet = C0ControlPercentEncodeSet.With(" \"<>`")
+	// QueryPercentEncodeSet is the set of the query of the non-special url.
+	QueryPercentEncodeSet = C0ControlPercentEncodeSet.With(" \"#<>")
+	// SpecialQueryPercentEncodeSet is the set of the query of the special url.
+	SpecialQueryPercentEncodeSet = QueryPercentEncodeSet.With("'")
+	// PathPercentEncodeSet is the set of the path.
+	PathPercentEncodeSet = QueryPercentEncodeSet.With("?^`{}")
+	// UserinfoPercentEncodeSet is the set of the username and password.
+	UserinfoPercentEncodeSet = PathPercentEncodeSet.With("/:;=@[\\]^|")
+	// ComponentPercentEncodeSet is the set of encodeURIComponent.
+	ComponentPercentEncodeSet = UserinfoPercentEncodeSet.With("$%&+,")
+	// FormURLEncodedPercentEncodeSet is the set of
+	// application/x-www-form-urlencoded, the space is encoded as '+' by
+	// EscapeQuery instead.
+	FormURLEncodedPercentEncodeSet = ComponentPercentEncodeSet.With("!'()~")
+
+	// opaquePathEncodeSet holds the bytes which end or break the opaque path
+	opaquePathEncodeSet = func() PercentEncodeSet {
+		s := PercentEncodeSet{}.With("?#\x7f")
+		for c := uint(0); c < 0x20; c++ {
+			s[0] |= 1 << c
+		}
+		return s
+	}()
+)
+
+// PercentEncode appends src to dst, percent-encoding every byte in set.
+func PercentEncode(dst, src []byte, set *PercentEncodeSet) []byte {
+	for i := 0; i < len(src); i++ {
+		c := src[i]
+		if set.Contains(c) {
+			dst = append(dst, '%', "0123456789ABCDEF"[c>>4], "0123456789ABCDEF"[c&15])
+		} else {
+			dst = append(dst, c)
+		}
+	}
+	return dst
+}
+
+// PercentDecode appends src to dst, decoding every valid %xx. The '%' which
+// is not followed by two hex digits is kept like WHATWG, so it never fails.
+func PercentDecode(dst, src []byte) []byte {
+	for i := 0; i < len(src); i++ {
+		c := src[i]
+		if c == '%' && i+2 < len(src) && ishex(src[i+1]) && ishex(src[i+2]) {
+			c = unhex(src[i+1])<<4 | unhex(src[i+2])
+			i += 2
+		}
+		dst = append(dst, c)
+	}
+	return dst
 }
 
 type encoding int
@@ -42,6 +182,23 @@ const (
 	encodeFragment
 )
 
+// component returns the component of the url which the mode escapes.
+func (mode encoding) component() Component {
+	switch mode {
+	case encodePath, encodePathSegment:
+		return ComponentPath
+	case encodeHost, encodeZone:
+		return ComponentHost
+	case encodeUserPassword:
+		return ComponentUserinfo
+	case encodeQueryComponent:
+		return ComponentQuery
+	case encodeFragment:
+		return ComponentFragment
+	}
+	return 0
+}
+
 func ishex(c byte) bool {
 	switch {
 	case '0' <= c && c <= '9':
@@ -73,6 +230,32 @@ func UnescapeQuery(dst, src []byte) ([]byte, error) {
 	return unescape(dst, src, encodeQueryComponent)
 }
 
+// UnescapePath unescapes the path, '+' is kept.
+func UnescapePath(dst, src []byte) ([]byte, error) {
+	return unescape(dst, src, encodePath)
+}
+
+// UnescapePathSegment unescapes the path segment, '+' is kept.
+func UnescapePathSegment(dst, src []byte) ([]byte, error) {
+	return unescape(dst, src, encodePathSegment)
+}
+
+// UnescapeUserinfo unescapes the username or password.
+func UnescapeUserinfo(dst, src []byte) ([]byte, error) {
+	return unescape(dst, src, encodeUserPassword)
+}
+
+// UnescapeHost unescapes the host, only the non-ASCII bytes and %25 may be
+// escaped, see RFC 3986 section 3.2.2 and RFC 6874 section 2.
+func UnescapeHost(dst, src []byte) ([]byte, error) {
+	return unescape(dst, src, encodeHost)
+}
+
+// UnescapeFragment unescapes the fragment.
+func UnescapeFragment(dst, src []byte) ([]byte, error) {
+	return unescape(dst, src, encodeFragment)
+}
+
 // unescape unescapes a string; the mode specifies
 // which section of the URL string is being unescaped.
 //
@@ -80,17 +263,14 @@ func UnescapeQuery(dst, src []byte) ([]byte, error) {
 func unescape(dst, src []byte, mode encoding) ([]byte, error) {
 	// Count %, check that they're well-formed.
 	n := 0
-	hasPlus := false
+	hasPlus := mode == encodeQueryComponent && bytes.IndexByte(src, '+') >= 0
+	host := mode == encodeHost || mode == encodeZone
 	for i := 0; i < len(src); {
-		switch src[i] {
-		case '%':
+		switch {
+		case src[i] == '%':
 			n++
 			if i+2 >= len(src) || !ishex(src[i+1]) || !ishex(src[i+2]) {
-				src = src[i:]
-				if len(src) > 3 {
-					src = src[:3]
-				}
-				return nil, ErrFastURLInvalidCharacter
+				return nil, newParseError(mode.component(), ErrorKindInvalidPercentEncoding, src, 0, i, 3)
 			}
 
 			// Per https://tools.ietf.org/html/rfc3986#page-21
@@ -100,7 +280,7 @@ func unescape(dst, src []byte, mode encoding) ([]byte, error) {
 			// introduces %25 being allowed to escape a percent sign
 			// in IPv6 scoped-address literals. Yay.
 			if mode == encodeHost && unhex(src[i+1]) < 8 && string(src[i:i+3]) != "%25" {
-				return nil, ErrFastURLInvalidCharacter
+				return nil, newParseError(mode.component(), ErrorKindInvalidPercentEncoding, src, 0, i, 3)
 			}
 			if mode == encodeZone {
 				// RFC 6874 says basically "anything goes" for zone identifiers
@@ -112,18 +292,23 @@ func unescape(dst, src []byte, mode encoding) ([]byte, error) {
 				// But Windows puts spaces here! Yay.
 				v := unhex(src[i+1])<<4 | unhex(src[i+2])
 				if string(src[i:i+3]) != "%25" && v != ' ' && shouldEscape(v, encodeHost) {
-					return nil, ErrFastURLInvalidCharacter
+					return nil, newParseError(mode.component(), ErrorKindInvalidPercentEncoding, src, 0, i, 3)
 				}
 			}
 			i += 3
-		case '+':
-			hasPlus = mode == encodeQueryComponent
+		case host:
+			if src[i] < 0x80 && escapeSets[mode].Contains(src[i]) {
+				return nil, newParseError(mode.component(), ErrorKindInvalidCharacter, src, 0, i, 1)
+			}
 			i++
 		default:
-			if (mode == encodeHost || mode == encodeZone) && src[i] < 0x80 && shouldEscape(src[i], mode) {
-				return nil, ErrFastURLInvalidCharacter
+			// Only '%' is checked, skip to the next one
+			j := bytes.IndexByte(src[i:], '%')
+			if j < 0 {
+				i = len(src)
+			} else {
+				i += j
 			}
-			i++
 		}
 	}
 
@@ -132,6 +317,22 @@ func unescape(dst, src []byte, mode encoding) ([]byte, error) {
 		return dst, nil
 	}
 
+	if !hasPlus {
+		// Append the bytes between the %xx at once
+		for i := 0; i < len(src); {
+			j := bytes.IndexByte(src[i:], '%')
+			if j < 0 {
+				dst = append(dst, src[i:]...)
+				break
+			}
+			dst = append(dst, src[i:i+j]...)
+			i += j
+			dst = append(dst, unhex(src[i+1])<<4|unhex(src[i+2]))
+			i += 3
+		}
+		return dst, nil
+	}
+
 	for i := 0; i < len(src); i++ {
 		switch src[i] {
 		case '%':
@@ -158,36 +359,109 @@ func EscapeQuery(dst, src []byte) []byte {
 	return escape(dst, src, encodeQueryComponent)
 }
 
+// EscapePath escapes the path, '/' is kept.
+func EscapePath(dst, src []byte) []byte {
+	return escape(dst, src, encodePath)
+}
+
+// EscapePathSegment escapes the path segment, '/', ';' and ',' are escaped.
+func EscapePathSegment(dst, src []byte) []byte {
+	return escape(dst, src, encodePathSegment)
+}
+
+// EscapeUserinfo escapes the username or password.
+func EscapeUserinfo(dst, src []byte) []byte {
+	return escape(dst, src, encodeUserPassword)
+}
+
+// EscapeHost escapes the host, the sub-delims, ':', '[' and ']' are kept.
+func EscapeHost(dst, src []byte) []byte {
+	return escape(dst, src, encodeHost)
+}
+
+// EscapeFragment escapes the fragment.
+func EscapeFragment(dst, src []byte) []byte {
+	return escape(dst, src, encodeFragment)
+}
+
 func escape(dst, src []byte, mode encoding) []byte {
-	spaceCount, hexCount := 0, 0
+	set := &escapeSets[mode]
 	for i := 0; i < len(src); i++ {
-		c := src[i]
-		if shouldEscape(c, mode) {
-			if c == ' ' && mode == encodeQueryComponent {
-				spaceCount++
-			} else {
-				hexCount++
+		// Append the bytes before the next one to escape at once, the words
+		// of the unreserved bytes, which no mode escapes, are skipped and
+		// the other words are checked byte by byte
+		j := i
+		for j < len(src) {
+			if j+8 <= len(src) && isUnreservedWord(binary.LittleEndian.Uint64(src[j:])) {
+				j += 8
+				continue
+			}
+			end := j + 8
+			if end > len(src) {
+				end = len(src)
+			}
+			for j < end && !set.Contains(src[j]) {
+				j++
 			}
+			if j < end {
+				break
+			}
+		}
+		dst = append(dst, src[i:j]...)
+		if j == len(src) {
+			break
 		}
+
+		c := src[j]
+		if c == ' ' && mode == encodeQueryComponent {
+			dst = append(dst, '+')
+		} else {
+			dst = append(dst, '%', "0123456789ABCDEF"[c>>4], "0123456789ABCDEF"[c&15])
+		}
+		i = j
 	}
 
-	// Fastpath
-	if spaceCount == 0 && hexCount == 0 {
-		dst = append(dst, src...)
-		return dst
+	return dst
+}
+
+// escapeSets holds the bytes which shouldEscape escapes in each mode.
+var escapeSets = func() (sets [encodeFragment + 1]PercentEncodeSet) {
+	for mode := encodePath; mode <= encodeFragment; mode++ {
+		for c := 0; c < 256; c++ {
+			if shouldEscape(byte(c), mode) {
+				sets[mode][c>>6] |= 1 << (uint(c) & 63)
+			}
+		}
+	}
+	return sets
+}()
+
+// escapeComponent escapes src like escape, but keeps the '%', the
+// sub-delims and the non-ASCII bytes of the host, so the component which is
+// parsed from a url is appended as it is and the component which is set is
+// parsed back to itself.
+func escapeComponent(dst, src []byte, mode encoding) []byte {
+	set := &escapeSets[mode]
+	hex := "0123456789ABCDEF"
+	if mode == encodeHost {
+		// Parse lowercases the host
+		hex = "0123456789abcdef"
 	}
 
 	for i := 0; i < len(src); i++ {
-		switch c := src[i]; {
-		case c == byte(' ') && mode == encodeQueryComponent:
-			dst = append(dst, '+')
-		case shouldEscape(c, mode):
-			dst = append(dst, '%', "0123456789ABCDEF"[c>>4], "0123456789ABCDEF"[c&15])
-		default:
+		c := src[i]
+		switch {
+		case c == '%':
+			// The '%' without two hex digits is kept by Parse too, and the
+			// escaped byte after it never starts with a hex digit
 			dst = append(dst, c)
+		case c >= 0x80 && mode == encodeHost, c == '\\' && mode == encodePath,
+			isSubDelim(c), !set.Contains(c):
+			dst = append(dst, c)
+		default:
+			dst = append(dst, '%', hex[c>>4], hex[c&15])
 		}
 	}
-
 	return dst
 }
 
@@ -305,42 +579,51 @@ func NormalizePathname(dst, src []byte) []byte {
 	}
 	dst = dst[:bSize]
 
-	// remove /./ parts
-	b = dst
-	for {
-		n := bytes.Index(b, []byte("/./"))
-		if n < 0 {
-			break
-		}
-		nn := n + len([]byte("/./")) - 1
-		copy(b[n:], b[nn:])
-		b = b[:len(b)-nn+n]
-	}
-
-	// remove /foo/../ parts
-	for {
-		n := bytes.Index(b, []byte("/../"))
-		if n < 0 {
-			break
-		}
-		nn := bytes.LastIndexByte(b[:n], '/')
-		if nn < 0 {
-			nn = 0
-		}
-		n += len([]byte("/../")) - 1
-		copy(b[nn:], b[n:])
-		b = b[:len(b)-n+nn]
-	}
+	return removeDotSegments(dst)
+}
 
-	// remove trailing /foo/..
-	n := bytes.LastIndex(b, []byte("/.."))
-	if n >= 0 && n+len([]byte("/..")) == len(b) {
-		nn := bytes.LastIndexByte(b[:n], '/')
-		if nn < 0 {
-			return []byte("/")
+// removeDotSegments removes the "." and ".." segments of the path in place.
+//
+// More detail see https://tools.ietf.org/html/rfc3986#section-5.2.4
+func removeDotSegments(b []byte) []byte {
+	// The output b[:w] never overtakes the input b[i:]
+	w := 0
+	for i := 0; i < len(b); {
+		in := b[i:]
+		switch {
+		case bytes.HasPrefix(in, []byte("../")):
+			i += 3
+		case bytes.HasPrefix(in, []byte("./")):
+			i += 2
+		case bytes.HasPrefix(in, []byte("/./")):
+			i += 2
+		case string(in) == "/.":
+			b[i+1] = '/'
+			i++
+		case bytes.HasPrefix(in, []byte("/../")), string(in) == "/..":
+			if len(in) == 3 {
+				b[i+2] = '/'
+				i += 2
+			} else {
+				i += 3
+			}
+			// Remove the last segment of the output
+			w = bytes.LastIndexByte(b[:w], '/')
+			if w < 0 {
+				w = 0
+			}
+		case string(in) == "." || string(in) == "..":
+			i = len(b)
+		default:
+			j := bytes.IndexByte(in[1:], '/')
+			if j < 0 {
+				j = len(in)
+			} else {
+				j++
+			}
+			w += copy(b[w:], in[:j])
+			i += j
 		}
-		b = b[:nn+1]
 	}
-
-	return b
+	return b[:w]
 }
@@ -0,0 +1,18 @@
+//go:build amd64 && !purego
+// +build amd64,!purego
+
+package fasturl
+
+// hasASCIIControl reports whether b has a C0 control or DEL. SSE2 is always
+// available on amd64, so the implementation is selected by the build tags
+// rather than by a CPU feature check at init. The short input is checked by
+// SWAR to save the call.
+func hasASCIIControl(b []byte) bool {
+	if len(b) < 16 {
+		return hasASCIIControlGeneric(b)
+	}
+	return hasASCIIControlSSE2(b)
+}
+
+//go:noescape
+func hasASCIIControlSSE2(b []byte) bool
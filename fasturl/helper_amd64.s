@@ -0,0 +1,59 @@
+//go:build amd64 && !purego
+// +build amd64,!purego
+
+#include "textflag.h"
+
+// func hasASCIIControlSSE2(b []byte) bool
+TEXT ·hasASCIIControlSSE2(SB), NOSPLIT, $0-25
+	MOVQ b_base+0(FP), SI
+	MOVQ b_len+8(FP), BX
+
+	// X1 = 0x1f x 16, X2 = 0x7f x 16
+	MOVQ       $0x1f1f1f1f1f1f1f1f, AX
+	MOVQ       AX, X1
+	PUNPCKLQDQ X1, X1
+	MOVQ       $0x7f7f7f7f7f7f7f7f, AX
+	MOVQ       AX, X2
+	PUNPCKLQDQ X2, X2
+
+loop:
+	CMPQ BX, $16
+	JB   tail
+
+	// The byte is at most 0x1f if min(byte, 0x1f) is itself
+	MOVOU    (SI), X0
+	MOVOU    X0, X3
+	PMINUB   X1, X3
+	PCMPEQB  X0, X3
+	PCMPEQB  X2, X0
+	POR      X3, X0
+	PMOVMSKB X0, AX
+	TESTL    AX, AX
+	JNZ      found
+	ADDQ     $16, SI
+	SUBQ     $16, BX
+	JMP      loop
+
+tail:
+	// Check the last 16 bytes again, the input has 16 bytes at least
+	MOVQ     b_base+0(FP), DI
+	TESTQ    BX, BX
+	JZ       notfound
+	MOVQ     b_len+8(FP), CX
+	MOVOU    -16(DI)(CX*1), X0
+	MOVOU    X0, X3
+	PMINUB   X1, X3
+	PCMPEQB  X0, X3
+	PCMPEQB  X2, X0
+	POR      X3, X0
+	PMOVMSKB X0, AX
+	TESTL    AX, AX
+	JNZ      found
+
+notfound:
+	MOVB $0, ret+24(FP)
+	RET
+
+found:
+	MOVB $1, ret+24(FP)
+	RET
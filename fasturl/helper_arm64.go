@@ -0,0 +1,18 @@
+//go:build arm64 && !purego
+// +build arm64,!purego
+
+package fasturl
+
+// hasASCIIControl reports whether b has a C0 control or DEL. NEON is always
+// available on arm64, so the implementation is selected by the build tags
+// rather than by a CPU feature check at init. The short input is checked by
+// SWAR to save the call.
+func hasASCIIControl(b []byte) bool {
+	if len(b) < 16 {
+		return hasASCIIControlGeneric(b)
+	}
+	return hasASCIIControlNEON(b)
+}
+
+//go:noescape
+func hasASCIIControlNEON(b []byte) bool
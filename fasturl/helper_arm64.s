@@ -0,0 +1,57 @@
+//go:build arm64 && !purego
+// +build arm64,!purego
+
+#include "textflag.h"
+
+// func hasASCIIControlNEON(b []byte) bool
+TEXT ·hasASCIIControlNEON(SB), NOSPLIT, $0-25
+	MOVD b_base+0(FP), R0
+	MOVD b_len+8(FP), R1
+	ADD  R0, R1, R7
+
+	// V1 = 0xe0 x 16, V2 = 0x7f x 16, V5 = 0 x 16
+	MOVD $0xe0, R2
+	VDUP R2, V1.B16
+	MOVD $0x7f, R2
+	VDUP R2, V2.B16
+	VEOR V5.B16, V5.B16, V5.B16
+
+loop:
+	CMP $16, R1
+	BLO tail
+
+	// The byte is below 0x20 if its top 3 bits are clear
+	VLD1.P 16(R0), [V0.B16]
+	VAND   V1.B16, V0.B16, V3.B16
+	VCMEQ  V5.B16, V3.B16, V3.B16
+	VCMEQ  V2.B16, V0.B16, V4.B16
+	VORR   V3.B16, V4.B16, V3.B16
+	VMOV   V3.D[0], R4
+	VMOV   V3.D[1], R5
+	ORR    R4, R5, R4
+	CBNZ   R4, found
+	SUB    $16, R1
+	B      loop
+
+tail:
+	// Check the last 16 bytes again, the input has 16 bytes at least
+	CBZ   R1, notfound
+	SUB   $16, R7, R0
+	VLD1  (R0), [V0.B16]
+	VAND  V1.B16, V0.B16, V3.B16
+	VCMEQ V5.B16, V3.B16, V3.B16
+	VCMEQ V2.B16, V0.B16, V4.B16
+	VORR  V3.B16, V4.B16, V3.B16
+	VMOV  V3.D[0], R4
+	VMOV  V3.D[1], R5
+	ORR   R4, R5, R4
+	CBNZ  R4, found
+
+notfound:
+	MOVB ZR, ret+24(FP)
+	RET
+
+found:
+	MOVD $1, R4
+	MOVB R4, ret+24(FP)
+	RET
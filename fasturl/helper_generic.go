@@ -0,0 +1,9 @@
+//go:build (!amd64 && !arm64) || purego
+// +build !amd64,!arm64 purego
+
+package fasturl
+
+// hasASCIIControl reports whether b has a C0 control or DEL.
+func hasASCIIControl(b []byte) bool {
+	return hasASCIIControlGeneric(b)
+}
@@ -0,0 +1,203 @@
+package fasturl
+
+import (
+	"math/rand"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEscape(t *testing.T) {
+	for _, tt := range []struct {
+		escape   func(dst, src []byte) []byte
+		unescape func(dst, src []byte) ([]byte, error)
+		input    string
+		output   string
+	}{
+		{EscapeQuery, UnescapeQuery, "a b+c/d?e", "a+b%2Bc%2Fd%3Fe"},
+		{EscapePath, UnescapePath, "/a b+c/d?e", "/a%20b+c/d%3Fe"},
+		{EscapePathSegment, UnescapePathSegment, "a b/c;d,e", "a%20b%2Fc%3Bd%2Ce"},
+		{EscapeUserinfo, UnescapeUserinfo, "a:b@c/d", "a%3Ab%40c%2Fd"},
+		{EscapeHost, UnescapeHost, "[::1]:80", "[::1]:80"},
+		{EscapeHost, UnescapeHost, "café.com", "caf%C3%A9.com"},
+		{EscapeFragment, UnescapeFragment, "a b#c!", "a%20b%23c!"},
+	} {
+		b := tt.escape(nil, []byte(tt.input))
+		require.Equal(t, tt.output, string(b), tt.input)
+
+		d, err := tt.unescape(nil, b)
+		require.Nil(t, err, tt.input)
+		require.Equal(t, tt.input, string(d), tt.input)
+	}
+
+	// The unescapers report the invalid escapes
+	for _, unescape := range []func(dst, src []byte) ([]byte, error){
+		UnescapeQuery, UnescapePath, UnescapePathSegment, UnescapeUserinfo, UnescapeHost, UnescapeFragment,
+	} {
+		_, err := unescape(nil, []byte("a%zz"))
+		require.NotNil(t, err)
+	}
+	_, err := UnescapeHost(nil, []byte("a%20b"))
+	require.NotNil(t, err)
+
+	// The path is kept as it is
+	d, err := UnescapePath(nil, []byte("a+b"))
+	require.Nil(t, err)
+	require.Equal(t, "a+b", string(d))
+}
+
+func TestEscapeLikeNetURL(t *testing.T) {
+	var all []byte
+	for c := 0; c < 256; c++ {
+		all = append(all, byte(c))
+	}
+	require.Equal(t, url.QueryEscape(string(all)), string(EscapeQuery(nil, all)))
+	require.Equal(t, url.PathEscape(string(all)), string(EscapePathSegment(nil, all)))
+}
+
+func TestPercentEncodeSet(t *testing.T) {
+	const special = " \"#$%&'()*+,/:;<=>?@[\\]^`{|}~!"
+	for _, tt := range []struct {
+		set    *PercentEncodeSet
+		output string
+	}{
+		{&C0ControlPercentEncodeSet, special},
+		{&FragmentPercentEncodeSet, "%20%22#$%&'()*+,/:;%3C=%3E?@[\\]^%60{|}~!"},
+		{&QueryPercentEncodeSet, "%20%22%23$%&'()*+,/:;%3C=%3E?@[\\]^`{|}~!"},
+		{&SpecialQueryPercentEncodeSet, "%20%22%23$%&%27()*+,/:;%3C=%3E?@[\\]^`{|}~!"},
+		{&PathPercentEncodeSet, "%20%22%23$%&'()*+,/:;%3C=%3E%3F@[\\]%5E%60%7B|%7D~!"},
+		{&UserinfoPercentEncodeSet, "%20%22%23$%&'()*+,%2F%3A%3B%3C%3D%3E%3F%40%5B%5C%5D%5E%60%7B%7C%7D~!"},
+		{&ComponentPercentEncodeSet, "%20%22%23%24%25%26'()*%2B%2C%2F%3A%3B%3C%3D%3E%3F%40%5B%5C%5D%5E%60%7B%7C%7D~!"},
+		{&FormURLEncodedPercentEncodeSet, "%20%22%23%24%25%26%27%28%29*%2B%2C%2F%3A%3B%3C%3D%3E%3F%40%5B%5C%5D%5E%60%7B%7C%7D%7E%21"},
+	} {
+		require.Equal(t, tt.output, string(PercentEncode(nil, []byte(special), tt.set)))
+		// The controls and the non-ASCII bytes are in every set
+		require.Equal(t, "%00%1F%7F%C3%A9", string(PercentEncode(nil, []byte("\x00\x1f\x7fé"), tt.set)))
+		require.False(t, tt.set.Contains('a'))
+	}
+
+	require.Equal(t, "a b%é%2", string(PercentDecode(nil, []byte("a%20b%%C3%a9%2"))))
+}
+
+// hasASCIIControlBytewise is the byte loop hasASCIIControl is checked and
+// benchmarked against.
+func hasASCIIControlBytewise(b []byte) bool {
+	for _, c := range b {
+		if c < ' ' || c == 0x7f {
+			return true
+		}
+	}
+	return false
+}
+
+// escapeBytewise is the byte loop escape is checked and benchmarked against.
+func escapeBytewise(dst, src []byte, mode encoding) []byte {
+	for _, c := range src {
+		switch {
+		case c == ' ' && mode == encodeQueryComponent:
+			dst = append(dst, '+')
+		case shouldEscape(c, mode):
+			dst = append(dst, '%', "0123456789ABCDEF"[c>>4], "0123456789ABCDEF"[c&15])
+		default:
+			dst = append(dst, c)
+		}
+	}
+	return dst
+}
+
+func TestIsUnreservedWord(t *testing.T) {
+	for c := 0; c < 256; c++ {
+		want := 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' ||
+			c == '-' || c == '.' || c == '_' || c == '~'
+		// Every byte at every position of the word
+		for i := uint(0); i < 8; i++ {
+			x := uint64(swarOnes*'a')&^(0xff<<(8*i)) | uint64(c)<<(8*i)
+			require.Equal(t, want, isUnreservedWord(x), "%#x at %d", c, i)
+		}
+	}
+}
+
+func TestEscapeLikeBytewise(t *testing.T) {
+	alpha := []byte("aZ09-._~ /?%+&=:@\x00\x7f\x80\xff")
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 20000; n++ {
+		b := make([]byte, r.Intn(40))
+		for i := range b {
+			if r.Intn(4) == 0 {
+				b[i] = alpha[r.Intn(len(alpha))]
+			} else {
+				b[i] = "abcXYZ0189-._~"[r.Intn(14)]
+			}
+		}
+		for mode := encodePath; mode <= encodeFragment; mode++ {
+			require.Equal(t, string(escapeBytewise(nil, b, mode)), string(escape(nil, b, mode)), "%d %q", mode, b)
+		}
+	}
+}
+
+func TestHasASCIIControl(t *testing.T) {
+	naive := hasASCIIControlBytewise
+
+	// Every byte at every position of the inputs around the word sizes
+	for n := 1; n <= 40; n++ {
+		b := []byte(strings.Repeat("a", n))
+		for i := 0; i < n; i++ {
+			for c := 0; c < 256; c++ {
+				b[i] = byte(c)
+				want := naive(b)
+				require.Equal(t, want, hasASCIIControlGeneric(b), "%d %q", n, b)
+				require.Equal(t, want, hasASCIIControl(b), "%d %q", n, b)
+			}
+			b[i] = 'a'
+		}
+	}
+	require.False(t, hasASCIIControl(nil))
+	require.False(t, hasASCIIControlGeneric(nil))
+
+	// The control in the 16-byte tail, which overlaps the blocks before it
+	for _, n := range []int{16, 17, 31, 32, 33, 47, 63} {
+		for _, c := range []byte{0x00, 0x1f, 0x7f} {
+			b := []byte(strings.Repeat("a", n))
+			require.False(t, hasASCIIControl(b), n)
+			b[n-1] = c
+			require.True(t, hasASCIIControl(b), "%d %q", n, c)
+			if n > 16 {
+				b[n-1] = 'a'
+				b[n-16] = c
+				require.True(t, hasASCIIControl(b), "%d %q", n, c)
+			}
+		}
+	}
+
+	// The bytes above 0x7f are not controls
+	b := []byte(strings.Repeat("\x80\xff\x7e ", 10))
+	require.False(t, hasASCIIControlGeneric(b))
+	require.False(t, hasASCIIControl(b))
+}
+
+func TestUnescapeLikeNetURL(t *testing.T) {
+	alpha := []byte("ab+%2Ef0 /?é")
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 20000; n++ {
+		b := make([]byte, r.Intn(32))
+		for i := range b {
+			b[i] = alpha[r.Intn(len(alpha))]
+		}
+
+		want, wantErr := url.QueryUnescape(string(b))
+		got, err := UnescapeQuery(nil, b)
+		require.Equal(t, wantErr == nil, err == nil, "%q", b)
+		if err == nil {
+			require.Equal(t, want, string(got), "%q", b)
+		}
+
+		want, wantErr = url.PathUnescape(string(b))
+		got, err = UnescapePathSegment(nil, b)
+		require.Equal(t, wantErr == nil, err == nil, "%q", b)
+		if err == nil {
+			require.Equal(t, want, string(got), "%q", b)
+		}
+	}
+}
@@ -0,0 +1,586 @@
+package fasturl
+
+import (
+	"bytes"
+	"strconv"
+)
+
+// HostType is the type of the host.
+type HostType int
+
+const (
+	// HostTypeNone indicates the url has no host.
+	HostTypeNone HostType = iota
+	// HostTypeDomain indicates the host is a domain.
+	HostTypeDomain
+	// HostTypeIPv4 indicates the host is an IPv4 address.
+	HostTypeIPv4
+	// HostTypeIPv6 indicates the host is a bracketed IPv6 address.
+	HostTypeIPv6
+	// HostTypeOpaque indicates the host of a non-special url.
+	HostTypeOpaque
+)
+
+// String returns the name of the host type.
+func (t HostType) String() string {
+	switch t {
+	case HostTypeNone:
+		return "none"
+	case HostTypeDomain:
+		return "domain"
+	case HostTypeIPv4:
+		return "ipv4"
+	case HostTypeIPv6:
+		return "ipv6"
+	case HostTypeOpaque:
+		return "opaque"
+	}
+	return "unknown"
+}
+
+// parseHost splits the host into hostname and port, which the caller keeps
+// consistent with the host by buildHost. The src is the host as it appears
+// at offset base of the input. If domain is true, the hostname is a domain
+// or an IPv4 address, which is percent-decoded, otherwise it is opaque. If
+// idna is true, the domain is converted to its ASCII form.
+//
+// More detail see https://url.spec.whatwg.org/#concept-host-parser
+func parseHost(f *FastURL, src []byte, base int, domain, idna, ipvFuture bool) error {
+	host := f.host
+	if len(host) > 0 && host[0] == '[' {
+		i := bytes.IndexByte(host, ']')
+		if i < 0 {
+			return newParseError(ComponentHost, ErrorKindInvalidIPv6, src, base, 0, len(src))
+		}
+
+		if rest := host[i+1:]; len(rest) > 0 {
+			if rest[0] != ':' {
+				return newParseError(ComponentHost, ErrorKindInvalidCharacter, src, base, i+1, 1)
+			}
+			f.port = append(f.port[:0], rest[1:]...)
+		}
+
+		if ipvFuture && isIPvFuture(host[1:i]) {
+			f.hostname = append(f.hostname[:0], host[:i+1]...)
+			f.hostType = HostTypeOpaque
+			return nil
+		}
+
+		var ok bool
+		f.hostname, ok = appendIPv6Literal(f.hostname[:0], host[1:i])
+		if !ok {
+			return newParseError(ComponentHost, ErrorKindInvalidIPv6, src, base, 0, i+1)
+		}
+		f.hostType = HostTypeIPv6
+		return nil
+	}
+
+	// find :
+	ci := bytes.IndexByte(host, ':')
+	if ci >= 0 {
+		f.hostname = append(f.hostname[:0], host[:ci]...)
+		f.port = append(f.port[:0], host[ci+1:]...)
+	} else {
+		f.hostname = append(f.hostname[:0], host...)
+	}
+	n := len(f.hostname)
+
+	if domain {
+		if bytes.IndexByte(f.hostname, '%') >= 0 {
+			// Reuse host, which has been split already, as the buffer
+			f.host = PercentDecode(f.host[:0], f.hostname)
+			f.host, f.hostname = f.hostname, f.host
+			toLowercsaeASCII(f.hostname)
+		}
+		if idna && hasIDNA(f.hostname) {
+			var err error
+			f.host, err = DomainToASCII(f.host[:0], f.hostname)
+			if err != nil {
+				return newParseError(ComponentHost, ErrorKindInvalidDomain, src, base, 0, n)
+			}
+			f.host, f.hostname = f.hostname, f.host
+		}
+		for i := 0; i < len(f.hostname); i++ {
+			if isForbiddenDomainCodePoint(f.hostname[i]) {
+				return newParseError(ComponentHost, ErrorKindInvalidDomain, src, base, 0, n)
+			}
+		}
+	} else {
+		for i := 0; i < len(f.hostname); i++ {
+			if isForbiddenHostCodePoint(f.hostname[i]) {
+				return newParseError(ComponentHost, ErrorKindInvalidCharacter, src, base, i, 1)
+			}
+		}
+	}
+
+	switch {
+	case len(f.hostname) == 0:
+		f.hostType = HostTypeNone
+	case !domain:
+		f.hostType = HostTypeOpaque
+	case endsInANumber(f.hostname):
+		addr, ok := parseIPv4(f.hostname)
+		if !ok {
+			return newParseError(ComponentHost, ErrorKindInvalidIPv4, src, base, 0, n)
+		}
+		f.hostname = appendIPv4(f.hostname[:0], addr)
+		f.hostType = HostTypeIPv4
+	default:
+		f.hostType = HostTypeDomain
+	}
+
+	return nil
+}
+
+// classifyHost sets the type of the hostname which is set directly, the
+// hostname is taken as it is.
+func classifyHost(f *FastURL) {
+	h := f.hostname
+	switch {
+	case len(h) == 0:
+		f.hostType = HostTypeNone
+	case h[0] == '[' && len(h) > 1:
+		if isIPvFuture(h[1 : len(h)-1]) {
+			f.hostType = HostTypeOpaque
+		} else {
+			f.hostType = HostTypeIPv6
+		}
+	case bytes.IndexByte(h, ':') >= 0:
+		f.hostType = HostTypeIPv6
+	case len(f.protocol) > 0 && (f.scheme == nil || !f.scheme.Special):
+		f.hostType = HostTypeOpaque
+	case endsInANumber(h):
+		if _, ok := parseIPv4(h); ok {
+			f.hostType = HostTypeIPv4
+		} else {
+			f.hostType = HostTypeDomain
+		}
+	default:
+		f.hostType = HostTypeDomain
+	}
+}
+
+// portIndex returns the index of the colon before the port in the host, or
+// -1 if the host has no port.
+func portIndex(host []byte) int {
+	if len(host) > 0 && host[0] == '[' {
+		i := bytes.IndexByte(host, ']')
+		if i < 0 || i+1 >= len(host) || host[i+1] != ':' {
+			return -1
+		}
+		return i + 1
+	}
+	return bytes.IndexByte(host, ':')
+}
+
+// parsePort checks the port is a number in the range 0 to 65535 and removes
+// its leading zeros, src is the host at offset base of the input.
+//
+// More detail see https://url.spec.whatwg.org/#port-state
+func parsePort(f *FastURL, src []byte, base int) error {
+	port := f.port
+	if err := checkPort(port, base+portIndex(src)+1); err != nil {
+		return err
+	}
+
+	i := 0
+	for i < len(port)-1 && port[i] == '0' {
+		i++
+	}
+	if i > 0 {
+		f.port = append(f.port[:0], port[i:]...)
+	}
+	return nil
+}
+
+// checkPort checks the port at offset base of the input is a number in the
+// range 0 to 65535.
+func checkPort(port []byte, base int) error {
+	v := 0
+	for i := 0; i < len(port); i++ {
+		c := port[i]
+		if c < '0' || c > '9' {
+			return newParseError(ComponentPort, ErrorKindInvalidPort, port, base, i, 1)
+		}
+		v = v*10 + int(c-'0')
+		if v > 65535 {
+			return newParseError(ComponentPort, ErrorKindPortOutOfRange, port, base, 0, len(port))
+		}
+	}
+	return nil
+}
+
+// buildHost keeps the host consistent with the hostname and port.
+func buildHost(f *FastURL) {
+	f.host = append(f.host[:0], f.hostname...)
+	if len(f.port) > 0 {
+		f.host = append(f.host, ':')
+		f.host = append(f.host, f.port...)
+	}
+}
+
+// isForbiddenHostCodePoint reports whether c can not appear in a host.
+//
+// More detail see https://url.spec.whatwg.org/#forbidden-host-code-point
+func isForbiddenHostCodePoint(c byte) bool {
+	switch c {
+	case 0, '\t', '\n', '\r', ' ', '#', '/', ':', '<', '>', '?', '@', '[', '\\', ']', '^', '|':
+		return true
+	}
+	return false
+}
+
+// isForbiddenDomainCodePoint reports whether c can not appear in a domain.
+//
+// More detail see https://url.spec.whatwg.org/#forbidden-domain-code-point
+func isForbiddenDomainCodePoint(c byte) bool {
+	return c < ' ' || c == '%' || c == 0x7f || isForbiddenHostCodePoint(c)
+}
+
+// endsInANumber reports whether the last label of the domain is a number.
+//
+// More detail see https://url.spec.whatwg.org/#ends-in-a-number-checker
+func endsInANumber(b []byte) bool {
+	if len(b) > 0 && b[len(b)-1] == '.' {
+		b = b[:len(b)-1]
+		if len(b) == 0 {
+			return false
+		}
+	}
+	if i := bytes.LastIndexByte(b, '.'); i >= 0 {
+		b = b[i+1:]
+	}
+	if len(b) == 0 {
+		return false
+	}
+
+	digits := true
+	for i := 0; i < len(b); i++ {
+		if b[i] < '0' || b[i] > '9' {
+			digits = false
+			break
+		}
+	}
+	if digits {
+		return true
+	}
+
+	if len(b) < 2 || b[0] != '0' || (b[1] != 'x' && b[1] != 'X') {
+		return false
+	}
+	for i := 2; i < len(b); i++ {
+		if !ishex(b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// parseIPv4 parses the IPv4 address which may use the decimal, octal or
+// hexadecimal form for each of its 1 to 4 parts.
+//
+// More detail see https://url.spec.whatwg.org/#concept-ipv4-parser
+func parseIPv4(src []byte) (uint32, bool) {
+	if len(src) > 0 && src[len(src)-1] == '.' {
+		src = src[:len(src)-1]
+	}
+
+	var numbers [4]uint64
+	n := 0
+	for {
+		part := src
+		i := bytes.IndexByte(src, '.')
+		if i >= 0 {
+			part, src = src[:i], src[i+1:]
+		}
+
+		if n == 4 {
+			return 0, false
+		}
+		v, ok := parseIPv4Number(part)
+		if !ok {
+			return 0, false
+		}
+		numbers[n] = v
+		n++
+
+		if i < 0 {
+			break
+		}
+	}
+
+	for i := 0; i < n-1; i++ {
+		if numbers[i] > 255 {
+			return 0, false
+		}
+	}
+	if numbers[n-1] >= 1<<(8*uint(5-n)) {
+		return 0, false
+	}
+
+	addr := numbers[n-1]
+	for i := 0; i < n-1; i++ {
+		addr += numbers[i] << (8 * uint(3-i))
+	}
+	return uint32(addr), true
+}
+
+// parseIPv4Number parses one part of an IPv4 address. Values which do not
+// fit in 32 bits are reported as 1<<32 so the caller rejects them.
+//
+// More detail see https://url.spec.whatwg.org/#ipv4-number-parser
+func parseIPv4Number(b []byte) (uint64, bool) {
+	if len(b) == 0 {
+		return 0, false
+	}
+
+	radix := uint64(10)
+	if len(b) >= 2 && b[0] == '0' && (b[1] == 'x' || b[1] == 'X') {
+		radix = 16
+		b = b[2:]
+	} else if len(b) >= 2 && b[0] == '0' {
+		radix = 8
+		b = b[1:]
+	}
+
+	var v uint64
+	for i := 0; i < len(b); i++ {
+		c := b[i]
+		var d uint64
+		switch {
+		case radix == 16 && ishex(c):
+			d = uint64(unhex(c))
+		case '0' <= c && c <= '9' && uint64(c-'0') < radix:
+			d = uint64(c - '0')
+		default:
+			return 0, false
+		}
+		if v <= 1<<32 {
+			v = v*radix + d
+		}
+		if v > 1<<32 {
+			v = 1 << 32
+		}
+	}
+	return v, true
+}
+
+// appendIPv4 appends the dotted-decimal form of addr to dst.
+func appendIPv4(dst []byte, addr uint32) []byte {
+	for i := 3; i >= 0; i-- {
+		dst = strconv.AppendUint(dst, uint64(addr>>(8*uint(i))&0xff), 10)
+		if i > 0 {
+			dst = append(dst, '.')
+		}
+	}
+	return dst
+}
+
+// appendIPv6Literal appends the bracketed canonical form of the IPv6
+// address in src, which may carry a RFC 6874 zone identifier, to dst.
+func appendIPv6Literal(dst, src []byte) ([]byte, bool) {
+	var zone []byte
+	if zi := bytes.Index(src, []byte("%25")); zi >= 0 {
+		src, zone = src[:zi], src[zi+3:]
+		if !isZoneID(zone) {
+			return dst, false
+		}
+	}
+
+	addr, ok := parseIPv6(src)
+	if !ok {
+		return dst, false
+	}
+
+	dst = append(dst, '[')
+	dst = appendIPv6(dst, &addr)
+	if len(zone) > 0 {
+		dst = append(dst, "%25"...)
+		dst = append(dst, zone...)
+	}
+	dst = append(dst, ']')
+	return dst, true
+}
+
+// isZoneID reports whether b is a valid RFC 6874 ZoneID:
+//
+//	ZoneID = 1*( unreserved / pct-encoded )
+func isZoneID(b []byte) bool {
+	if len(b) == 0 {
+		return false
+	}
+	for i := 0; i < len(b); i++ {
+		c := b[i]
+		switch {
+		case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9':
+		case c == '-', c == '.', c == '_', c == '~':
+		case c == '%':
+			if i+2 >= len(b) || !ishex(b[i+1]) || !ishex(b[i+2]) {
+				return false
+			}
+			i += 2
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// parseIPv6 parses the IPv6 address without brackets.
+//
+// More detail see https://url.spec.whatwg.org/#concept-ipv6-parser
+func parseIPv6(src []byte) (addr [8]uint16, ok bool) {
+	pieceIndex := 0
+	compress := -1
+	i := 0
+
+	if len(src) > 0 && src[0] == ':' {
+		if len(src) < 2 || src[1] != ':' {
+			return addr, false
+		}
+		i += 2
+		pieceIndex++
+		compress = pieceIndex
+	}
+
+	for i < len(src) {
+		if pieceIndex == 8 {
+			return addr, false
+		}
+
+		if src[i] == ':' {
+			if compress >= 0 {
+				return addr, false
+			}
+			i++
+			pieceIndex++
+			compress = pieceIndex
+			continue
+		}
+
+		value, length := uint16(0), 0
+		for length < 4 && i < len(src) && ishex(src[i]) {
+			value = value<<4 | uint16(unhex(src[i]))
+			i++
+			length++
+		}
+
+		if i < len(src) && src[i] == '.' {
+			// Embedded IPv4 address
+			if length == 0 || pieceIndex > 6 {
+				return addr, false
+			}
+			i -= length
+
+			numbersSeen := 0
+			for i < len(src) {
+				if numbersSeen > 0 {
+					if src[i] != '.' || numbersSeen >= 4 {
+						return addr, false
+					}
+					i++
+				}
+
+				if i >= len(src) || src[i] < '0' || src[i] > '9' {
+					return addr, false
+				}
+
+				piece := -1
+				for i < len(src) && src[i] >= '0' && src[i] <= '9' {
+					n := int(src[i] - '0')
+					switch piece {
+					case -1:
+						piece = n
+					case 0:
+						return addr, false
+					default:
+						piece = piece*10 + n
+					}
+					if piece > 255 {
+						return addr, false
+					}
+					i++
+				}
+
+				addr[pieceIndex] = addr[pieceIndex]<<8 | uint16(piece)
+				numbersSeen++
+				if numbersSeen == 2 || numbersSeen == 4 {
+					pieceIndex++
+				}
+			}
+
+			if numbersSeen != 4 {
+				return addr, false
+			}
+			break
+		}
+
+		if i < len(src) {
+			if src[i] != ':' {
+				return addr, false
+			}
+			i++
+			if i == len(src) {
+				return addr, false
+			}
+		}
+
+		addr[pieceIndex] = value
+		pieceIndex++
+	}
+
+	if compress >= 0 {
+		swaps := pieceIndex - compress
+		pieceIndex = 7
+		for pieceIndex != 0 && swaps > 0 {
+			addr[pieceIndex], addr[compress+swaps-1] = addr[compress+swaps-1], addr[pieceIndex]
+			pieceIndex--
+			swaps--
+		}
+	} else if pieceIndex != 8 {
+		return addr, false
+	}
+
+	return addr, true
+}
+
+// appendIPv6 appends the compressed textual form of addr to dst.
+//
+// More detail see https://url.spec.whatwg.org/#concept-ipv6-serializer
+func appendIPv6(dst []byte, addr *[8]uint16) []byte {
+	// Find the first longest sequence of two or more zero pieces
+	compress, longest := -1, 1
+	for i := 0; i < 8; {
+		if addr[i] != 0 {
+			i++
+			continue
+		}
+		j := i
+		for j < 8 && addr[j] == 0 {
+			j++
+		}
+		if j-i > longest {
+			compress, longest = i, j-i
+		}
+		i = j
+	}
+
+	for i := 0; i < 8; i++ {
+		if i == compress {
+			if i == 0 {
+				dst = append(dst, "::"...)
+			} else {
+				dst = append(dst, ':')
+			}
+			i += longest - 1
+			continue
+		}
+
+		dst = strconv.AppendUint(dst, uint64(addr[i]), 16)
+		if i != 7 {
+			dst = append(dst, ':')
+		}
+	}
+
+	return dst
+}
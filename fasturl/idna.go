@@ -0,0 +1,871 @@
+package fasturl
+
+import (
+	"bytes"
+	"unicode"
+	"unicode/utf8"
+)
+
+//go:generate go run gen_idna_tables.go
+
+// idnaMapping maps the code points lo to hi.
+//
+// A non-zero stride maps every stride-th code point r in the range to
+// r+delta, otherwise the code point is mapped to the string to, which
+// is empty for ignored code points.
+type idnaMapping struct {
+	lo, hi rune
+	stride uint8
+	delta  int32
+	to     string
+}
+
+// idnaRange gives the value to the code points lo to hi.
+type idnaRange struct {
+	lo, hi rune
+	value  uint8
+}
+
+// idnaDecomposition is the full canonical decomposition of r.
+type idnaDecomposition struct {
+	r  rune
+	to string
+}
+
+// idnaComposition is the primary composite c of the pair a, b.
+type idnaComposition struct {
+	a, b, c rune
+}
+
+// The Bidi classes which CheckBidi tells apart, every other class is
+// bidiOther.
+//
+// More detail see https://tools.ietf.org/html/rfc5893#section-2
+const (
+	bidiL uint8 = iota
+	bidiR
+	bidiAL
+	bidiAN
+	bidiEN
+	bidiES
+	bidiCS
+	bidiET
+	bidiON
+	bidiBN
+	bidiNSM
+	bidiOther
+)
+
+// The joining types which CheckJoiners tells apart, every other type is
+// joiningU.
+//
+// More detail see https://tools.ietf.org/html/rfc5892#appendix-A.1
+const (
+	joiningU uint8 = iota
+	joiningL
+	joiningD
+	joiningR
+	joiningT
+)
+
+const (
+	zeroWidthNonJoiner = '\u200c'
+	zeroWidthJoiner    = '\u200d'
+	cccVirama          = 9
+)
+
+// The Hangul syllables are decomposed and composed algorithmically.
+//
+// More detail see https://www.unicode.org/versions/Unicode15.0.0/ch03.pdf#G56669
+const (
+	hangulSBase  = 0xac00
+	hangulLBase  = 0x1100
+	hangulVBase  = 0x1161
+	hangulTBase  = 0x11a7
+	hangulLCount = 19
+	hangulVCount = 21
+	hangulTCount = 28
+	hangulNCount = hangulVCount * hangulTCount
+	hangulSCount = hangulLCount * hangulNCount
+)
+
+const (
+	punycodeBase        = 36
+	punycodeTMin        = 1
+	punycodeTMax        = 26
+	punycodeSkew        = 38
+	punycodeDamp        = 700
+	punycodeInitialBias = 72
+	punycodeInitialN    = 128
+	punycodeMaxInt      = 1<<31 - 1
+)
+
+const acePrefix = "xn--"
+
+// DomainToASCII appends the ASCII form of the domain to dst following the
+// nontransitional processing of UTS #46 ToASCII with the flags of the WHATWG
+// domain to ASCII: CheckBidi and CheckJoiners are set, CheckHyphens,
+// UseSTD3ASCIIRules and VerifyDnsLength are not.
+//
+// The domain is mapped, normalized to NFC and validated with the embedded
+// tables, which are generated from the Unicode data files of the version
+// idnaUnicodeVersion, so the result does not depend on the Go toolchain. The
+// labels which contain non-ASCII code points are converted to Punycode.
+//
+// More detail see https://www.unicode.org/reports/tr46/#ToASCII
+func DomainToASCII(dst, src []byte) ([]byte, error) {
+	return processDomain(dst, src, true)
+}
+
+// DomainToUnicode appends the Unicode form of the domain to dst following the
+// nontransitional processing of UTS #46 ToUnicode, any error of the
+// processing is returned.
+//
+// More detail see https://www.unicode.org/reports/tr46/#ToUnicode
+func DomainToUnicode(dst, src []byte) ([]byte, error) {
+	return processDomain(dst, src, false)
+}
+
+// processDomain maps src to the tail of dst and then converts it label by
+// label, so the only memory it needs is the spare capacity of dst.
+//
+// More detail see https://www.unicode.org/reports/tr46/#Processing
+func processDomain(dst, src []byte, toASCII bool) ([]byte, error) {
+	start := len(dst)
+
+	var ok bool
+	dst, ok = appendIDNAMapped(dst, src)
+	if !ok {
+		return dst[:start], ErrFastURLInvalidCharacter
+	}
+	if !isNFCQuick(dst[start:]) {
+		n := len(dst)
+		dst = appendNFC(dst, dst[start:n])
+		dst = append(dst[:start], dst[n:]...)
+	}
+	mapped := dst[start:]
+
+	// Convert the labels to Unicode and validate them
+	unicodeStart := len(dst)
+	rtl := false
+	for len(mapped) > 0 {
+		label := mapped
+		i := bytes.IndexByte(mapped, '.')
+		if i >= 0 {
+			label, mapped = mapped[:i], mapped[i+1:]
+		} else {
+			mapped = nil
+		}
+
+		n := len(dst)
+		decoded := hasACEPrefix(label)
+		if decoded {
+			if !isASCII(label) {
+				return dst[:start], ErrFastURLInvalidCharacter
+			}
+			dst, ok = appendPunycodeDecoded(dst, label[len(acePrefix):])
+			if !ok || isASCII(dst[n:]) || !isNFC(&dst, n) {
+				return dst[:start], ErrFastURLInvalidCharacter
+			}
+		} else {
+			dst = append(dst, label...)
+		}
+		if !isValidIDNALabel(dst[n:], decoded) {
+			return dst[:start], ErrFastURLInvalidCharacter
+		}
+		rtl = rtl || hasRTL(dst[n:])
+
+		if i >= 0 {
+			dst = append(dst, '.')
+		}
+	}
+	result := dst[unicodeStart:]
+
+	if rtl {
+		// Every label of a Bidi domain name follows the Bidi rule
+		for u := result; len(u) > 0; {
+			label := u
+			if i := bytes.IndexByte(u, '.'); i >= 0 {
+				label, u = u[:i], u[i+1:]
+			} else {
+				u = nil
+			}
+			if !isValidBidiLabel(label) {
+				return dst[:start], ErrFastURLInvalidCharacter
+			}
+		}
+	}
+
+	if toASCII {
+		asciiStart := len(dst)
+		for u := result; len(u) > 0; {
+			label := u
+			i := bytes.IndexByte(u, '.')
+			if i >= 0 {
+				label, u = u[:i], u[i+1:]
+			} else {
+				u = nil
+			}
+
+			if isASCII(label) {
+				dst = append(dst, label...)
+			} else {
+				dst = append(dst, acePrefix...)
+				dst, ok = appendPunycode(dst, label)
+				if !ok {
+					return dst[:start], ErrFastURLInvalidCharacter
+				}
+			}
+
+			if i >= 0 {
+				dst = append(dst, '.')
+			}
+		}
+		result = dst[asciiStart:]
+	}
+
+	// Move the converted domain over the mapped one
+	n := copy(dst[start:], result)
+	return dst[:start+n], nil
+}
+
+// appendIDNAMapped appends src mapped by the UTS #46 mapping to dst.
+func appendIDNAMapped(dst, src []byte) ([]byte, bool) {
+	for i := 0; i < len(src); {
+		c := src[i]
+		if c < utf8.RuneSelf {
+			if 'A' <= c && c <= 'Z' {
+				c += 'a' - 'A'
+			}
+			dst = append(dst, c)
+			i++
+			continue
+		}
+
+		r, size := utf8.DecodeRune(src[i:])
+		if r == utf8.RuneError && size == 1 {
+			return dst, false
+		}
+		i += size
+
+		m := lookupIDNAMapping(r)
+		switch {
+		case m == nil:
+			if isDisallowedRune(r) {
+				return dst, false
+			}
+			dst = append(dst, src[i-size:i]...)
+		case m.stride > 0:
+			dst = appendRune(dst, r+rune(m.delta))
+		default:
+			dst = append(dst, m.to...)
+		}
+	}
+	return dst, true
+}
+
+func lookupIDNAMapping(r rune) *idnaMapping {
+	lo, hi := 0, len(idnaMappings)
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		m := &idnaMappings[mid]
+		switch {
+		case r < m.lo:
+			hi = mid
+		case r > m.hi:
+			lo = mid + 1
+		default:
+			if m.stride > 1 && (r-m.lo)%rune(m.stride) != 0 {
+				return nil
+			}
+			return m
+		}
+	}
+	return nil
+}
+
+// lookupIDNARange returns the value of r in the table, the bool is false if
+// no range has r.
+func lookupIDNARange(table []idnaRange, r rune) (uint8, bool) {
+	lo, hi := 0, len(table)
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		switch {
+		case r < table[mid].lo:
+			hi = mid
+		case r > table[mid].hi:
+			lo = mid + 1
+		default:
+			return table[mid].value, true
+		}
+	}
+	return 0, false
+}
+
+// isDisallowedRune reports whether the status of the code point is
+// disallowed, which includes the unassigned code points.
+func isDisallowedRune(r rune) bool {
+	_, ok := lookupIDNARange(idnaDisallowed[:], r)
+	return ok
+}
+
+func isMark(r rune) bool {
+	_, ok := lookupIDNARange(idnaMarks[:], r)
+	return ok
+}
+
+func bidiClass(r rune) uint8 {
+	v, _ := lookupIDNARange(idnaBidiClasses[:], r)
+	return v
+}
+
+func joiningType(r rune) uint8 {
+	v, _ := lookupIDNARange(idnaJoiningTypes[:], r)
+	return v
+}
+
+func combiningClass(r rune) uint8 {
+	if r < utf8.RuneSelf {
+		return 0
+	}
+	v, _ := lookupIDNARange(idnaCombiningClasses[:], r)
+	return v
+}
+
+// isValidIDNALabel reports whether the label is valid, the Bidi rule is
+// checked by the caller for the whole domain. Labels which come from Punycode
+// must also be unaffected by the mapping.
+//
+// More detail see https://www.unicode.org/reports/tr46/#Validity_Criteria
+func isValidIDNALabel(label []byte, decoded bool) bool {
+	if len(label) == 0 {
+		return !decoded
+	}
+	if decoded && hasACEPrefix(label) {
+		return false
+	}
+
+	r, _ := utf8.DecodeRune(label)
+	if isMark(r) {
+		return false
+	}
+
+	for i := 0; i < len(label); {
+		c := label[i]
+		if c < utf8.RuneSelf {
+			if ('A' <= c && c <= 'Z') || c == '.' {
+				return false
+			}
+			i++
+			continue
+		}
+		r, size := utf8.DecodeRune(label[i:])
+		if (r == utf8.RuneError && size == 1) || lookupIDNAMapping(r) != nil || isDisallowedRune(r) {
+			return false
+		}
+		if (r == zeroWidthNonJoiner || r == zeroWidthJoiner) && !isValidJoiner(label, i, r) {
+			return false
+		}
+		i += size
+	}
+	return true
+}
+
+// isValidJoiner reports whether the ZWNJ or ZWJ at i of the label follows
+// the CONTEXTJ rule.
+//
+// More detail see https://tools.ietf.org/html/rfc5892#appendix-A.1
+func isValidJoiner(label []byte, i int, joiner rune) bool {
+	if i > 0 {
+		before, _ := utf8.DecodeLastRune(label[:i])
+		if combiningClass(before) == cccVirama {
+			return true
+		}
+	}
+	if joiner == zeroWidthJoiner {
+		return false
+	}
+
+	// (Joining_Type:{L,D})(Joining_Type:T)*\u200C(Joining_Type:T)*(Joining_Type:{R,D})
+	j := i
+	for {
+		if j == 0 {
+			return false
+		}
+		r, size := utf8.DecodeLastRune(label[:j])
+		t := joiningType(r)
+		if t == joiningL || t == joiningD {
+			break
+		}
+		if t != joiningT {
+			return false
+		}
+		j -= size
+	}
+	for j = i + utf8.RuneLen(joiner); j < len(label); {
+		r, size := utf8.DecodeRune(label[j:])
+		t := joiningType(r)
+		if t != joiningT {
+			return t == joiningR || t == joiningD
+		}
+		j += size
+	}
+	return false
+}
+
+// hasRTL reports whether the label has a right-to-left code point, which
+// makes the domain a Bidi domain name.
+//
+// More detail see https://tools.ietf.org/html/rfc5893#section-1.4
+func hasRTL(label []byte) bool {
+	for i := 0; i < len(label); {
+		if label[i] < utf8.RuneSelf {
+			i++
+			continue
+		}
+		r, size := utf8.DecodeRune(label[i:])
+		if c := bidiClass(r); c == bidiR || c == bidiAL || c == bidiAN {
+			return true
+		}
+		i += size
+	}
+	return false
+}
+
+// isValidBidiLabel reports whether the label of a Bidi domain name follows
+// the six conditions of the Bidi rule.
+//
+// More detail see https://tools.ietf.org/html/rfc5893#section-2
+func isValidBidiLabel(label []byte) bool {
+	if len(label) == 0 {
+		return true
+	}
+
+	r, _ := utf8.DecodeRune(label)
+	first := bidiClass(r)
+	if first != bidiL && first != bidiR && first != bidiAL {
+		return false
+	}
+	rtl := first != bidiL
+
+	var en, an bool
+	last := first
+	for _, r := range b2s(label) {
+		c := bidiClass(r)
+		switch c {
+		case bidiEN, bidiES, bidiCS, bidiET, bidiON, bidiBN, bidiNSM:
+		case bidiR, bidiAL, bidiAN:
+			if !rtl {
+				return false
+			}
+		case bidiL:
+			if rtl {
+				return false
+			}
+		default:
+			return false
+		}
+		en = en || c == bidiEN
+		an = an || c == bidiAN
+		if c != bidiNSM {
+			last = c
+		}
+	}
+
+	if rtl {
+		return (last == bidiR || last == bidiAL || last == bidiEN || last == bidiAN) && !(en && an)
+	}
+	return last == bidiL || last == bidiEN
+}
+
+// isNFCQuick reports whether b is in NFC without normalizing it, a false
+// result means b may not be in NFC.
+func isNFCQuick(b []byte) bool {
+	for i := 0; i < len(b); {
+		if b[i] < utf8.RuneSelf {
+			i++
+			continue
+		}
+		r, size := utf8.DecodeRune(b[i:])
+		if combiningClass(r) != 0 || len(lookupDecomposition(r)) > 0 || isCompositionSecond(r) {
+			return false
+		}
+		i += size
+	}
+	return true
+}
+
+// isNFC reports whether (*b)[start:] is in NFC, the tail of *b is used to
+// normalize it.
+func isNFC(b *[]byte, start int) bool {
+	if isNFCQuick((*b)[start:]) {
+		return true
+	}
+	n := len(*b)
+	*b = appendNFC(*b, (*b)[start:n])
+	nfc := bytes.Equal((*b)[start:n], (*b)[n:])
+	*b = (*b)[:n]
+	return nfc
+}
+
+// appendNFC appends the NFC form of src to dst.
+//
+// More detail see https://www.unicode.org/reports/tr15/#Description_Norm
+func appendNFC(dst, src []byte) []byte {
+	start := len(dst)
+	for i := 0; i < len(src); {
+		if src[i] < utf8.RuneSelf {
+			dst = append(dst, src[i])
+			i++
+			continue
+		}
+
+		r, size := utf8.DecodeRune(src[i:])
+		if s := r - hangulSBase; s >= 0 && s < hangulSCount {
+			dst = appendRune(dst, hangulLBase+s/hangulNCount)
+			dst = appendRune(dst, hangulVBase+s%hangulNCount/hangulTCount)
+			if t := s % hangulTCount; t > 0 {
+				dst = appendRune(dst, hangulTBase+t)
+			}
+		} else if d := lookupDecomposition(r); len(d) > 0 {
+			dst = append(dst, d...)
+		} else {
+			dst = append(dst, src[i:i+size]...)
+		}
+		i += size
+	}
+
+	reorderCanonical(dst[start:])
+	return composeCanonical(dst, start)
+}
+
+// reorderCanonical sorts every run of non-starters of b by their combining
+// classes in place, the sort is stable.
+func reorderCanonical(b []byte) {
+	for i := 0; i < len(b); {
+		r, size := utf8.DecodeRune(b[i:])
+		ccc := combiningClass(r)
+		i += size
+		if ccc == 0 {
+			continue
+		}
+
+		// Move r before the runes of the greater combining classes
+		j := i - size
+		for j > 0 {
+			p, psize := utf8.DecodeLastRune(b[:j])
+			if combiningClass(p) <= ccc {
+				break
+			}
+			var tmp [utf8.UTFMax]byte
+			copy(tmp[:], b[j:j+size])
+			copy(b[j-psize+size:], b[j-psize:j])
+			copy(b[j-psize:], tmp[:size])
+			j -= psize
+		}
+	}
+}
+
+// composeCanonical composes the decomposed dst[start:] in place.
+func composeCanonical(dst []byte, start int) []byte {
+	w := start
+	starter := -1
+	var last uint8
+	for i := start; i < len(dst); {
+		r, size := utf8.DecodeRune(dst[i:])
+		ccc := combiningClass(r)
+
+		if starter >= 0 {
+			s, ssize := utf8.DecodeRune(dst[starter:])
+			// r is blocked by the rune before it unless that rune has a
+			// lower combining class, or r follows the starter
+			if w == starter+ssize || (last != 0 && last < ccc) {
+				if c, ok := lookupComposition(s, r); ok {
+					n := utf8.RuneLen(c)
+					copy(dst[starter+n:], dst[starter+ssize:w])
+					utf8.EncodeRune(dst[starter:], c)
+					w += n - ssize
+					i += size
+					continue
+				}
+			}
+		}
+
+		copy(dst[w:], dst[i:i+size])
+		if ccc == 0 {
+			starter = w
+		}
+		last = ccc
+		w += size
+		i += size
+	}
+	return dst[:w]
+}
+
+func lookupDecomposition(r rune) string {
+	lo, hi := 0, len(idnaDecompositions)
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		switch d := &idnaDecompositions[mid]; {
+		case r < d.r:
+			hi = mid
+		case r > d.r:
+			lo = mid + 1
+		default:
+			return d.to
+		}
+	}
+	return ""
+}
+
+// lookupComposition returns the primary composite of the pair a, b.
+func lookupComposition(a, b rune) (rune, bool) {
+	if l, v := a-hangulLBase, b-hangulVBase; l >= 0 && l < hangulLCount && v >= 0 && v < hangulVCount {
+		return hangulSBase + (l*hangulVCount+v)*hangulTCount, true
+	}
+	if s, t := a-hangulSBase, b-hangulTBase; s >= 0 && s < hangulSCount && s%hangulTCount == 0 && t > 0 && t < hangulTCount {
+		return a + t, true
+	}
+
+	lo, hi := 0, len(idnaCompositions)
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		switch c := &idnaCompositions[mid]; {
+		case a < c.a || (a == c.a && b < c.b):
+			hi = mid
+		case a > c.a || b > c.b:
+			lo = mid + 1
+		default:
+			return c.c, true
+		}
+	}
+	return 0, false
+}
+
+// isCompositionSecond reports whether r may compose with the rune before it.
+func isCompositionSecond(r rune) bool {
+	if r >= hangulVBase && r < hangulVBase+hangulVCount || r > hangulTBase && r < hangulTBase+hangulTCount {
+		return true
+	}
+	_, ok := lookupIDNARange(idnaCompositionSeconds[:], r)
+	return ok
+}
+
+func hasACEPrefix(label []byte) bool {
+	return len(label) >= len(acePrefix) &&
+		(label[0] == 'x' || label[0] == 'X') &&
+		(label[1] == 'n' || label[1] == 'N') &&
+		label[2] == '-' && label[3] == '-'
+}
+
+// hasIDNA reports whether the domain needs IDNA processing, that is whether
+// it contains a non-ASCII byte or a label with the ACE prefix.
+func hasIDNA(domain []byte) bool {
+	for i := 0; i < len(domain); i++ {
+		if domain[i] >= utf8.RuneSelf {
+			return true
+		}
+		if (i == 0 || domain[i-1] == '.') && hasACEPrefix(domain[i:]) {
+			return true
+		}
+	}
+	return false
+}
+
+func isASCII(b []byte) bool {
+	for i := 0; i < len(b); i++ {
+		if b[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+func appendRune(dst []byte, r rune) []byte {
+	var b [utf8.UTFMax]byte
+	n := utf8.EncodeRune(b[:], r)
+	return append(dst, b[:n]...)
+}
+
+func punycodeAdapt(delta, numPoints int, firstTime bool) int {
+	if firstTime {
+		delta /= punycodeDamp
+	} else {
+		delta /= 2
+	}
+	delta += delta / numPoints
+	k := 0
+	for delta > ((punycodeBase-punycodeTMin)*punycodeTMax)/2 {
+		delta /= punycodeBase - punycodeTMin
+		k += punycodeBase
+	}
+	return k + (punycodeBase-punycodeTMin+1)*delta/(delta+punycodeSkew)
+}
+
+func punycodeThreshold(k, bias int) int {
+	t := k - bias
+	if t < punycodeTMin {
+		return punycodeTMin
+	}
+	if t > punycodeTMax {
+		return punycodeTMax
+	}
+	return t
+}
+
+func punycodeEncodeDigit(d int) byte {
+	if d < 26 {
+		return byte('a' + d)
+	}
+	return byte('0' + d - 26)
+}
+
+func punycodeDecodeDigit(c byte) (int, bool) {
+	switch {
+	case '0' <= c && c <= '9':
+		return int(c-'0') + 26, true
+	case 'a' <= c && c <= 'z':
+		return int(c - 'a'), true
+	case 'A' <= c && c <= 'Z':
+		return int(c - 'A'), true
+	}
+	return 0, false
+}
+
+// appendPunycode appends the Punycode encoding of the UTF-8 label to dst.
+//
+// More detail see https://tools.ietf.org/html/rfc3492#section-6.3
+func appendPunycode(dst, label []byte) ([]byte, bool) {
+	s := b2s(label)
+
+	total, basic := 0, 0
+	for _, r := range s {
+		if r < punycodeInitialN {
+			dst = append(dst, byte(r))
+			basic++
+		}
+		total++
+	}
+	if basic > 0 {
+		dst = append(dst, '-')
+	}
+
+	n, delta, bias := punycodeInitialN, 0, punycodeInitialBias
+	for h := basic; h < total; {
+		m := punycodeMaxInt
+		for _, r := range s {
+			if int(r) >= n && int(r) < m {
+				m = int(r)
+			}
+		}
+
+		if m-n > (punycodeMaxInt-delta)/(h+1) {
+			return dst, false
+		}
+		delta += (m - n) * (h + 1)
+		n = m
+
+		for _, r := range s {
+			if int(r) < n {
+				delta++
+				if delta > punycodeMaxInt-1 {
+					return dst, false
+				}
+			}
+			if int(r) != n {
+				continue
+			}
+
+			q := delta
+			for k := punycodeBase; ; k += punycodeBase {
+				t := punycodeThreshold(k, bias)
+				if q < t {
+					break
+				}
+				dst = append(dst, punycodeEncodeDigit(t+(q-t)%(punycodeBase-t)))
+				q = (q - t) / (punycodeBase - t)
+			}
+			dst = append(dst, punycodeEncodeDigit(q))
+			bias = punycodeAdapt(delta, h+1, h == basic)
+			delta = 0
+			h++
+		}
+		delta++
+		n++
+	}
+
+	return dst, true
+}
+
+// appendPunycodeDecoded appends the UTF-8 label decoded from the Punycode
+// src to dst.
+//
+// More detail see https://tools.ietf.org/html/rfc3492#section-6.2
+func appendPunycodeDecoded(dst, src []byte) ([]byte, bool) {
+	start := len(dst)
+	count := 0
+
+	in := 0
+	if b := bytes.LastIndexByte(src, '-'); b > 0 {
+		for i := 0; i < b; i++ {
+			if src[i] >= utf8.RuneSelf {
+				return dst, false
+			}
+		}
+		dst = append(dst, src[:b]...)
+		count = b
+		in = b + 1
+	}
+
+	n, i, bias := punycodeInitialN, 0, punycodeInitialBias
+	for in < len(src) {
+		oldi, w := i, 1
+		for k := punycodeBase; ; k += punycodeBase {
+			if in >= len(src) {
+				return dst, false
+			}
+			digit, ok := punycodeDecodeDigit(src[in])
+			in++
+			if !ok || digit > (punycodeMaxInt-i)/w {
+				return dst, false
+			}
+			i += digit * w
+
+			t := punycodeThreshold(k, bias)
+			if digit < t {
+				break
+			}
+			if w > punycodeMaxInt/(punycodeBase-t) {
+				return dst, false
+			}
+			w *= punycodeBase - t
+		}
+
+		count++
+		bias = punycodeAdapt(i-oldi, count, oldi == 0)
+		if i/count > punycodeMaxInt-n {
+			return dst, false
+		}
+		n += i / count
+		i %= count
+
+		r := rune(n)
+		if r < punycodeInitialN || r > unicode.MaxRune || (r >= 0xd800 && r <= 0xdfff) {
+			return dst, false
+		}
+
+		// Insert the code point before the i-th code point
+		pos := start
+		for j := 0; j < i; j++ {
+			_, size := utf8.DecodeRune(dst[pos:])
+			pos += size
+		}
+		size := utf8.RuneLen(r)
+		dst = append(dst, "\x00\x00\x00\x00"[:size]...)
+		copy(dst[pos+size:], dst[pos:len(dst)-size])
+		utf8.EncodeRune(dst[pos:], r)
+		i++
+	}
+
+	return dst, true
+}
@@ -0,0 +1,235 @@
+package fasturl
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPunycode(t *testing.T) {
+	for _, tt := range []struct {
+		unicode  string
+		punycode string
+	}{
+		{"münchen", "mnchen-3ya"},
+		{"bücher", "bcher-kva"},
+		{"ü", "tda"},
+		{"他们为什么不说中文", "ihqwcrb4cv8a8dqg056pqjye"},
+		{"3年b組金八先生", "3b-ww4c5e180e575a65lsy2b"},
+		{"правительство", "80aealotwbjpid2k"},
+	} {
+		d, ok := appendPunycode(nil, []byte(tt.unicode))
+		require.True(t, ok, tt.unicode)
+		require.Equal(t, tt.punycode, string(d), tt.unicode)
+
+		d, ok = appendPunycodeDecoded([]byte("prefix"), []byte(tt.punycode))
+		require.True(t, ok, tt.punycode)
+		require.Equal(t, "prefix"+tt.unicode, string(d), tt.punycode)
+	}
+
+	for _, tt := range []string{"99999999999", "-a", "a-!"} {
+		_, ok := appendPunycodeDecoded(nil, []byte(tt))
+		require.False(t, ok, tt)
+	}
+}
+
+func TestDomainToASCII(t *testing.T) {
+	for _, tt := range []struct {
+		input   string
+		ascii   string
+		unicode string
+		haserr  bool
+	}{
+		{input: "example.com", ascii: "example.com", unicode: "example.com"},
+		{input: "München.DE", ascii: "xn--mnchen-3ya.de", unicode: "münchen.de"},
+		{input: "xn--mnchen-3ya.de", ascii: "xn--mnchen-3ya.de", unicode: "münchen.de"},
+		{input: "ＥＸＡＭＰＬＥ。com", ascii: "example.com", unicode: "example.com"},
+		{input: "faß.de", ascii: "xn--fa-hia.de", unicode: "faß.de"},
+		{input: "soft\u00adhyphen.com", ascii: "softhyphen.com", unicode: "softhyphen.com"},
+		{input: "bücher.example.", ascii: "xn--bcher-kva.example.", unicode: "bücher.example."},
+		{input: "xn--abc.com", haserr: true},
+		{input: "xn--a.com", haserr: true},
+		{input: "\u0301a.com", haserr: true},
+		{input: "a\ue000.com", haserr: true},
+		{input: "a\xff.com", haserr: true},
+		{input: "mu\u0308nchen.de", ascii: "xn--mnchen-3ya.de", unicode: "münchen.de"},
+		{input: "가\u11a8.kr", ascii: "xn--p39a.kr", unicode: "각.kr"},
+		{input: "xn--u-ccb.de", haserr: true},
+		{input: "a\u200db.com", haserr: true},
+		{input: "a\u094d\u200db.com", ascii: "xn--ab-fsf014u.com", unicode: "a\u094d\u200db.com"},
+		{input: "\u05d0a.com", haserr: true},
+		{input: "\u05d0\u05d1.com", ascii: "xn--4dbc.com", unicode: "\u05d0\u05d1.com"},
+		{input: "xn--xn--a--gua.pt", haserr: true},
+	} {
+		d, err := DomainToASCII([]byte("prefix:"), []byte(tt.input))
+		if tt.haserr {
+			require.NotNil(t, err, tt.input)
+			require.Equal(t, "prefix:", string(d), tt.input)
+			continue
+		}
+		require.Nil(t, err, tt.input)
+		require.Equal(t, "prefix:"+tt.ascii, string(d), tt.input)
+
+		d, err = DomainToUnicode(nil, []byte(tt.input))
+		require.Nil(t, err, tt.input)
+		require.Equal(t, tt.unicode, string(d), tt.input)
+	}
+}
+
+// idnaTestV2 are lines of IdnaTestV2.txt of Unicode 16.0.0, see
+// https://www.unicode.org/Public/idna/16.0.0/IdnaTestV2.txt
+const idnaTestV2 = `
+fass.de; ; ; ; ; ;
+faß.de; ; ; xn--fa-hia.de; ; fass.de;
+Faß.de; faß.de; ; xn--fa-hia.de; ; fass.de;
+xn--fa-hia.de; faß.de; ; xn--fa-hia.de; ; ;
+FAẞ.de; faß.de; ; xn--fa-hia.de; ; fass.de;
+βόλος.com; ; ; xn--nxasmm1c.com; ; xn--nxasmq6b.com;
+βο\u0301λος.com; βόλος.com; ; xn--nxasmm1c.com; ; xn--nxasmq6b.com;
+Βο\u0301λος.com; βόλος.com; ; xn--nxasmm1c.com; ; xn--nxasmq6b.com;
+à\u05D0; ; [B5, B6]; xn--0ca24w; ; ;
+a\u0300\u05D0; à\u05D0; [B5, B6]; xn--0ca24w; ; ;
+xn--0ca24w; à\u05D0; [B5, B6]; xn--0ca24w; ; ;
+0à.\u05D0; ; [B1]; xn--0-sfa.xn--4db; ; ;
+0A\u0300.\u05D0; 0à.\u05D0; [B1]; xn--0-sfa.xn--4db; ; ;
+\u05D0a\u05C7; ; [B2, B3]; xn--a-ihcz; ; ;
+xn--a-ihcz; \u05D0a\u05C7; [B2, B3]; xn--a-ihcz; ; ;
+à.\u05D00\u0660\u05D0; ; [B4]; xn--0ca.xn--0-zhcb98c; ; ;
+à.\u05D0\u0308; ; ; xn--0ca.xn--ssa73l; ; ;
+A\u0300.\u05D0\u0308; à.\u05D0\u0308; ; xn--0ca.xn--ssa73l; ; ;
+xn--0ca.xn--ssa73l; à.\u05D0\u0308; ; xn--0ca.xn--ssa73l; ; ;
+a\u200Cb; ; [C1]; xn--ab-j1t; ; ab; []
+xn--ab-j1t; a\u200Cb; [C1]; xn--ab-j1t; ; ;
+a\u094D\u200Cb; ; ; xn--ab-fsf604u; ; xn--ab-fsf;
+xn--ab-fsf604u; a\u094D\u200Cb; ; xn--ab-fsf604u; ; ;
+\u0644\u200C\u06ED\u06EF; ; ; xn--ghb25aga828w; ; xn--ghb25aga;
+\u0644\u200C; ; [B3, C1]; xn--ghb413k; ; xn--ghb; []
+a\u200Db; ; [C2]; xn--ab-m1t; ; ab; []
+xn--ab-fsf014u; a\u094D\u200Db; ; xn--ab-fsf014u; ; ;
+a\u0628\u0308\u200C\u0308\u0628b; ; [B5]; xn--ab-uuba211bca8057b; ; xn--ab-uuba211bca;
+xn--u-ccb; u\u0308; [V1]; xn--u-ccb; ; ;
+xn--xn--a--gua.pt; xn--a-ä.pt; [V2, V4]; xn--xn--a--gua.pt; ; ;
+\u0308.\u05D0; ; [B1, V6]; xn--ssa.xn--4db; ; ;
+xn--ssa.xn--4db; \u0308.\u05D0; [B1, V6]; xn--ssa.xn--4db; ; ;
+a⒈com; ; [V7]; xn--acom-0w1b; ; ;
+A⒈COM; a⒈com; [V7]; xn--acom-0w1b; ; ;
+xn--0.pt; ; [P4]; ; ; ;
+xn--a-ä.pt; ; [P4]; xn--xn--a--gua.pt; ; ;
+xn---; ; [P4]; ; ; ;
+""; ; [X4_2]; ; [A4_1, A4_2]; ;
+。; .; [X4_2]; ; [A4_1, A4_2]; ;
+a.b..-q--ä-.e; ; [V2, V3, X4_2]; a.b..xn---q----jra.e; [V2, V3, A4_2]; ;
+⑷.four; (4).four; [U1]; ; ; ;
+`
+
+// unescapeIDNATest unescapes the \uXXXX and \x{X...} of the field, "" is the
+// empty string and the empty field is def.
+func unescapeIDNATest(field, def string) string {
+	switch field {
+	case "":
+		return def
+	case `""`:
+		return ""
+	}
+	var b []byte
+	for i := 0; i < len(field); i++ {
+		var hex string
+		switch {
+		case strings.HasPrefix(field[i:], `\u`):
+			hex = field[i+2 : i+6]
+			i += 5
+		case strings.HasPrefix(field[i:], `\x{`):
+			end := strings.IndexByte(field[i:], '}')
+			hex = field[i+3 : i+end]
+			i += end
+		default:
+			b = append(b, field[i])
+			continue
+		}
+		r, err := strconv.ParseUint(hex, 16, 32)
+		if err != nil {
+			panic(err)
+		}
+		b = appendRune(b, rune(r))
+	}
+	return string(b)
+}
+
+// hasIDNATestError reports whether the status has an error of the flags of
+// DomainToASCII, VerifyDnsLength, CheckHyphens and UseSTD3ASCIIRules are
+// not set.
+func hasIDNATestError(status string) bool {
+	for _, code := range strings.Split(strings.Trim(status, "[]"), ",") {
+		switch strings.TrimSpace(code) {
+		case "", "A4_1", "A4_2", "X4_2", "V2", "V3", "U1":
+		default:
+			return true
+		}
+	}
+	return false
+}
+
+func TestDomainToASCIIConformance(t *testing.T) {
+	for _, line := range strings.Split(strings.TrimSpace(idnaTestV2), "\n") {
+		fields := strings.Split(line, ";")
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+		source := unescapeIDNATest(fields[0], "")
+		toUnicode := unescapeIDNATest(fields[1], source)
+		toASCII := unescapeIDNATest(fields[3], toUnicode)
+		unicodeStatus, asciiStatus := fields[2], fields[4]
+		if asciiStatus == "" {
+			asciiStatus = unicodeStatus
+		}
+
+		d, err := DomainToASCII(nil, []byte(source))
+		if hasIDNATestError(asciiStatus) {
+			require.NotNil(t, err, line)
+		} else {
+			require.Nil(t, err, line)
+			require.Equal(t, toASCII, string(d), line)
+		}
+
+		d, err = DomainToUnicode(nil, []byte(source))
+		if hasIDNATestError(unicodeStatus) {
+			require.NotNil(t, err, line)
+		} else {
+			require.Nil(t, err, line)
+			require.Equal(t, toUnicode, string(d), line)
+		}
+	}
+}
+
+func TestFastURLParseIDNA(t *testing.T) {
+	var f FastURL
+	err := f.ParseWithOptions([]byte("http://MÜNCHEN.de:8080/a"), ParseOptions{IDNA: true})
+	require.Nil(t, err)
+	require.Equal(t, "xn--mnchen-3ya.de", string(f.GetHostname()))
+	require.Equal(t, "xn--mnchen-3ya.de:8080", string(f.GetHost()))
+	require.Equal(t, HostTypeDomain, f.GetHostType())
+
+	d, err := f.AppendHostnameUnicode(nil)
+	require.Nil(t, err)
+	require.Equal(t, "münchen.de", string(d))
+
+	f.Reset()
+	err = f.ParseWithOptions([]byte("http://１２７.０.０.１/"), ParseOptions{IDNA: true})
+	require.Nil(t, err)
+	require.Equal(t, "127.0.0.1", string(f.GetHostname()))
+	require.Equal(t, HostTypeIPv4, f.GetHostType())
+
+	f.Reset()
+	err = f.ParseWithOptions([]byte("http://a％b.com/"), ParseOptions{IDNA: true})
+	require.NotNil(t, err)
+
+	f.Reset()
+	err = f.Parse([]byte("http://münchen.de/"))
+	require.Nil(t, err)
+	require.Equal(t, "münchen.de", string(f.GetHostname()))
+	d, err = f.AppendHostnameASCII(nil)
+	require.Nil(t, err)
+	require.Equal(t, "xn--mnchen-3ya.de", string(d))
+}
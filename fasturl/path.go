@@ -18,10 +18,7 @@ func (p *Path) Encode(b []byte, showHash bool) []byte {
 		b = append(b, p.pathname...)
 	}
 
-	if p.query.Len() > 0 {
-		b = append(b, '?')
-		b = p.query.Encode(b)
-	}
+	b = appendSearch(b, p.parsequery, &p.query, p.rawquery)
 
 	if showHash {
 		if len(p.hash) > 0 {
@@ -56,6 +53,7 @@ func (p *Path) GetRawQuery() []byte {
 func (p *Path) GetQuery() *Query {
 	if !p.parsequery {
 		p.query.Decode(p.rawquery)
+		p.query.modified = false
 		p.parsequery = true
 	}
 	return &p.query
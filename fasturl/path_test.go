@@ -17,4 +17,11 @@ func TestPath(t *testing.T) {
 	v, ok := p.GetQuery().GetBytes([]byte("d"))
 	require.Equal(t, true, ok)
 	require.Equal(t, "1", string(v))
+	require.Equal(t, "/abcdef?d=1#hash", string(p.Encode(nil, true)))
+
+	p.Reset()
+	require.Nil(t, p.Parse([]byte("/a?b=%20&c")))
+	require.Equal(t, "/a?b=%20&c", string(p.Encode(nil, false)))
+	p.GetQuery().Add("d", "1")
+	require.Equal(t, "/a?b=+&c=&d=1", string(p.Encode(nil, false)))
 }
@@ -6,6 +6,8 @@ import "bytes"
 type QueryPair struct {
 	name  []byte
 	value []byte
+	// pos is the position of the pair before EncodeSorted sorts the pairs
+	pos int32
 }
 
 func (q *QueryPair) set(name, value []byte) {
@@ -13,6 +15,16 @@ func (q *QueryPair) set(name, value []byte) {
 	q.value = append(q.value[:0], value...)
 }
 
+// Name returns the name.
+func (q *QueryPair) Name() []byte {
+	return q.name
+}
+
+// Value returns the value.
+func (q *QueryPair) Value() []byte {
+	return q.value
+}
+
 func (q *QueryPair) reset() {
 	q.name = q.name[:0]
 	q.value = q.value[:0]
@@ -21,6 +33,19 @@ func (q *QueryPair) reset() {
 // Query holds slice of QueryPair
 type Query struct {
 	pairs []QueryPair
+	index queryIndex
+	// modified is set once the pairs are changed after GetQuery parses
+	// them, FastURL.Encode keeps the raw query until then
+	modified bool
+}
+
+// copyFrom copies the pairs of src to q, reusing the buffers of q.
+func (q *Query) copyFrom(src *Query) {
+	q.Reset()
+	for i := range src.pairs {
+		q.alloc().set(src.pairs[i].name, src.pairs[i].value)
+	}
+	q.modified = src.modified
 }
 
 // Len returns the length of query
@@ -34,6 +59,8 @@ func (q *Query) Reset() {
 		q.pairs[i].reset()
 	}
 	q.pairs = q.pairs[:0]
+	q.invalidateIndex()
+	q.modified = true
 }
 
 func (q *Query) GetAll(name string, fn func(value []byte) bool) {
@@ -42,7 +69,11 @@ func (q *Query) GetAll(name string, fn func(value []byte) bool) {
 
 // GetAllBytes returns all query who name is equal to name
 func (q *Query) GetAllBytes(name []byte, fn func(value []byte) bool) {
-	for i := range q.pairs {
+	start := q.first(name)
+	if start < 0 {
+		return
+	}
+	for i := start; i < len(q.pairs); i++ {
 		pair := q.pairs[i]
 		if bytes.Equal(pair.name, name) {
 			if !fn(pair.value) {
@@ -58,11 +89,8 @@ func (q *Query) Get(name string) ([]byte, bool) {
 
 // GetBytes gets the value from name
 func (q *Query) GetBytes(name []byte) ([]byte, bool) {
-	for i := range q.pairs {
-		pair := q.pairs[i]
-		if bytes.Equal(pair.name, name) {
-			return pair.value, true
-		}
+	if i := q.first(name); i >= 0 {
+		return q.pairs[i].value, true
 	}
 	return nil, false
 }
@@ -86,10 +114,14 @@ func (q *Query) DelAllBytes(name []byte) {
 }
 
 func (q *Query) del(name []byte, all bool) {
-	for i := 0; i < len(q.pairs); i++ {
+	start := q.first(name)
+	if start < 0 {
+		return
+	}
+	for i := start; i < len(q.pairs); i++ {
 		pair := q.pairs[i]
 		if bytes.Equal(pair.name, name) {
-			q.pairs = append(q.pairs[:i], q.pairs[i+1:]...)
+			q.removePair(i)
 			if !all {
 				return
 			}
@@ -98,6 +130,19 @@ func (q *Query) del(name []byte, all bool) {
 	}
 }
 
+// removePair removes the i-th pair, which is moved after the last pair so
+// that its buffers are reused by alloc and never shared with another pair.
+func (q *Query) removePair(i int) {
+	q.unindexPair(i)
+	n := len(q.pairs)
+	removed := q.pairs[i]
+	removed.reset()
+	copy(q.pairs[i:], q.pairs[i+1:])
+	q.pairs[n-1] = removed
+	q.pairs = q.pairs[:n-1]
+	q.modified = true
+}
+
 func (q *Query) Add(name, value string) {
 	q.AddBytes(s2b(name), s2b(value))
 }
@@ -106,6 +151,7 @@ func (q *Query) Add(name, value string) {
 func (q *Query) AddBytes(name, value []byte) {
 	pair := q.alloc()
 	pair.set(name, value)
+	q.modified = true
 }
 
 func (q *Query) Set(name, value string) {
@@ -114,11 +160,10 @@ func (q *Query) Set(name, value string) {
 
 // SetBytes sets or adds the name
 func (q *Query) SetBytes(name, value []byte) {
-	for i := range q.pairs {
-		if bytes.Equal(q.pairs[i].name, name) {
-			q.pairs[i].value = append(q.pairs[i].value[:0], value...)
-			return
-		}
+	if i := q.first(name); i >= 0 {
+		q.pairs[i].value = append(q.pairs[i].value[:0], value...)
+		q.modified = true
+		return
 	}
 
 	q.AddBytes(name, value)
@@ -138,6 +183,7 @@ func (q *Query) removeLastPair() {
 	if n := len(q.pairs); n >= 1 {
 		q.pairs = q.pairs[:n-1]
 	}
+	q.invalidateIndex()
 }
 
 // Range ranges the name
@@ -149,6 +195,56 @@ func (q *Query) Range(fn func(name, value []byte) bool) {
 	}
 }
 
+// Sort sorts the pairs by name and then value byte-wise, the order of the
+// equal pairs is kept.
+func (q *Query) Sort() {
+	q.SortFunc(lessQueryPair)
+}
+
+// SortFunc sorts the pairs by less, the order of the equal pairs is kept.
+func (q *Query) SortFunc(less func(a, b *QueryPair) bool) {
+	s := querySorter{pairs: q.pairs, less: less}
+	s.stable(len(q.pairs))
+	q.invalidateIndex()
+	q.modified = true
+}
+
+func lessQueryPair(a, b *QueryPair) bool {
+	if c := bytes.Compare(a.name, b.name); c != 0 {
+		return c < 0
+	}
+	return bytes.Compare(a.value, b.value) < 0
+}
+
+// EncodeSorted encodes the query to []byte in the order of Sort, the pairs
+// are sorted in place and put back in their order, so it does not allocate.
+func (q *Query) EncodeSorted(b []byte) []byte {
+	pairs := q.pairs
+	for i := range pairs {
+		pairs[i].pos = int32(i)
+	}
+	s := querySorter{pairs: pairs, less: lessQueryPair}
+	s.stable(len(pairs))
+
+	for i := range pairs {
+		b = EscapeQuery(b, pairs[i].name)
+		b = append(b, '=')
+		b = EscapeQuery(b, pairs[i].value)
+		if i < len(pairs)-1 {
+			b = append(b, '&')
+		}
+	}
+
+	// Put every pair back by following the cycles of the permutation
+	for i := range pairs {
+		for int(pairs[i].pos) != i {
+			j := pairs[i].pos
+			pairs[i], pairs[j] = pairs[j], pairs[i]
+		}
+	}
+	return b
+}
+
 // Decode decodes the querystring
 func (q *Query) Decode(b []byte) error {
 	return ParseQuery(q, b)
@@ -170,22 +266,30 @@ func (q *Query) Encode(b []byte) []byte {
 
 // ParseQuery parses the querystring to query
 func ParseQuery(q *Query, query []byte) error {
+	q.modified = true
 	var err error
+	// offset of key in the querystring
+	offset := 0
 	for len(query) > 0 {
 		key := query
+		next := offset + len(query)
 		if i := bytes.IndexAny(key, "&;"); i >= 0 {
 			key, query = key[:i], key[i+1:]
+			next = offset + i + 1
 		} else {
 			query = nil
 		}
 
 		if len(key) == 0 {
+			offset = next
 			continue
 		}
 
 		var value []byte
+		valueOffset := offset
 		if i := bytes.IndexByte(key, '='); i >= 0 {
 			key, value = key[:i], key[i+1:]
+			valueOffset += i + 1
 		}
 
 		pair := q.alloc()
@@ -193,14 +297,16 @@ func ParseQuery(q *Query, query []byte) error {
 		pair.name, err = UnescapeQuery(pair.name, key)
 		if err != nil {
 			q.removeLastPair()
-			return err
+			return offsetError(err, offset)
 		}
 
 		pair.value, err = UnescapeQuery(pair.value, value)
 		if err != nil {
 			q.removeLastPair()
-			return err
+			return offsetError(err, valueOffset)
 		}
+
+		offset = next
 	}
 
 	return err
@@ -1,6 +1,9 @@
 package fasturl
 
-import "testing"
+import (
+	"strconv"
+	"testing"
+)
 
 func BenchmarkQuery(b *testing.B) {
 	url := []byte("aaaa=1&b=2&c=3&g=3")
@@ -14,3 +17,123 @@ func BenchmarkQuery(b *testing.B) {
 		}
 	}
 }
+
+func BenchmarkStripRules(b *testing.B) {
+	var r StripRules
+	r.Add("fbclid", "gclid", "utm_*", "mc_?id")
+	r.AddForHost("amazon.com", "ref", "pf_rd_*")
+
+	url := []byte("utm_source=1&a=1&fbclid=2&b=2&ref=3&mc_eid=4&c=3&pf_rd_p=5")
+	host := []byte("www.amazon.com")
+
+	var q Query
+	for i := 0; i < b.N; i++ {
+		q.Reset()
+		err := q.Decode(url)
+		if err != nil {
+			b.Fatal("unexpected error", err)
+		}
+		if r.StripQuery(&q, host) != 5 {
+			b.Fatal("unexpected strip")
+		}
+	}
+}
+
+func BenchmarkQueryEncodeSorted(b *testing.B) {
+	var q Query
+	if err := q.Decode([]byte("utm_source=1&a=1&fbclid=2&b=2&ref=3&mc_eid=4&c=3&pf_rd_p=5")); err != nil {
+		b.Fatal("unexpected error", err)
+	}
+
+	var dst []byte
+	for i := 0; i < b.N; i++ {
+		dst = q.EncodeSorted(dst[:0])
+	}
+}
+
+func BenchmarkQueryGetLinear(b *testing.B) {
+	benchmarkQueryGet(b, false)
+}
+
+func BenchmarkQueryGetIndexed(b *testing.B) {
+	benchmarkQueryGet(b, true)
+}
+
+func benchmarkQueryGet(b *testing.B, index bool) {
+	var url []byte
+	for i := 0; i < 128; i++ {
+		url = append(url, "param"...)
+		url = strconv.AppendInt(url, int64(i), 10)
+		url = append(url, "=1&"...)
+	}
+	names := [][]byte{[]byte("param0"), []byte("param64"), []byte("param127"), []byte("missing")}
+
+	var q Query
+	if index {
+		q.EnableIndex()
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		q.Reset()
+		if err := q.Decode(url); err != nil {
+			b.Fatal("unexpected error", err)
+		}
+		for j := 0; j < 16; j++ {
+			for _, name := range names {
+				q.GetBytes(name)
+			}
+		}
+	}
+}
+
+func BenchmarkUnmarshalQuery(b *testing.B) {
+	var q Query
+	if err := q.Decode([]byte("q=fasturl&page=2&size=20&score=0.5&Verbose=true&timeout=1m30s")); err != nil {
+		b.Fatal("unexpected error", err)
+	}
+
+	var s querySearch
+	for i := 0; i < b.N; i++ {
+		if err := UnmarshalQuery(&q, &s); err != nil {
+			b.Fatal("unexpected error", err)
+		}
+	}
+}
+
+func BenchmarkMarshalQuery(b *testing.B) {
+	s := queryPage{Page: 2}
+	var q Query
+	for i := 0; i < b.N; i++ {
+		q.Reset()
+		if err := MarshalQuery(&q, &s); err != nil {
+			b.Fatal("unexpected error", err)
+		}
+	}
+}
+
+func BenchmarkQueryGetInt(b *testing.B) {
+	var q Query
+	if err := q.Decode([]byte("q=fasturl&page=2&size=20")); err != nil {
+		b.Fatal("unexpected error", err)
+	}
+
+	for i := 0; i < b.N; i++ {
+		if _, err := q.GetInt("size"); err != nil {
+			b.Fatal("unexpected error", err)
+		}
+	}
+}
+
+func BenchmarkQueryGetBytesAtoi(b *testing.B) {
+	var q Query
+	if err := q.Decode([]byte("q=fasturl&page=2&size=20")); err != nil {
+		b.Fatal("unexpected error", err)
+	}
+
+	for i := 0; i < b.N; i++ {
+		v, _ := q.GetBytes([]byte("size"))
+		if _, err := strconv.Atoi(string(v)); err != nil {
+			b.Fatal("unexpected error", err)
+		}
+	}
+}
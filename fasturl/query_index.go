@@ -0,0 +1,162 @@
+package fasturl
+
+import "bytes"
+
+// queryIndex is the open addressing hash table from the name to the first
+// pair with the name, see Query.EnableIndex.
+type queryIndex struct {
+	enabled bool
+	// slots holds the index of the pair plus one, zero is empty
+	slots []int32
+	// n is the number of the indexed pairs, -1 if the index is stale
+	n int
+}
+
+// EnableIndex enables the hash index of the names, which is built lazily by
+// the lookups and kept in insertion order, so GetBytes and SetBytes take O(1)
+// instead of scanning the pairs. DelBytes still moves the later pairs in O(n),
+// the index is updated in place rather than rebuilt. It pays for the queries
+// with many pairs, the index does not allocate after warm-up and survives
+// Reset.
+func (q *Query) EnableIndex() {
+	if !q.index.enabled {
+		q.index.enabled = true
+		q.index.n = -1
+	}
+}
+
+// invalidateIndex marks the index stale after the pairs are moved.
+func (q *Query) invalidateIndex() {
+	q.index.n = -1
+}
+
+// unindexPair updates the index before the i-th pair is removed, the later
+// pairs move down by one.
+func (q *Query) unindexPair(i int) {
+	x := &q.index
+	if !x.enabled || x.n <= i {
+		// The pair and the later ones are not indexed yet
+		return
+	}
+
+	slots := x.slots
+	mask := uint64(len(slots) - 1)
+	name := q.pairs[i].name
+	h := hashQueryName(name) & mask
+	for ; slots[h] != 0; h = (h + 1) & mask {
+		if bytes.Equal(q.pairs[slots[h]-1].name, name) {
+			break
+		}
+	}
+	if slots[h] == int32(i+1) {
+		next := -1
+		for j := i + 1; j < x.n; j++ {
+			if bytes.Equal(q.pairs[j].name, name) {
+				next = j
+				break
+			}
+		}
+		if next >= 0 {
+			slots[h] = int32(next + 1)
+		} else {
+			q.deleteSlot(h)
+		}
+	}
+
+	for k := range slots {
+		if slots[k] > int32(i+1) {
+			slots[k]--
+		}
+	}
+	x.n--
+}
+
+// deleteSlot empties the slot h and moves the later slots of the probe
+// sequence back, so no lookup stops at the hole.
+func (q *Query) deleteSlot(h uint64) {
+	slots := q.index.slots
+	mask := uint64(len(slots) - 1)
+	for j := (h + 1) & mask; slots[j] != 0; j = (j + 1) & mask {
+		home := hashQueryName(q.pairs[slots[j]-1].name) & mask
+		// The slot stays if its home is cyclically in (h, j]
+		if h <= j && h < home && home <= j || h > j && (h < home || home <= j) {
+			continue
+		}
+		slots[h] = slots[j]
+		h = j
+	}
+	slots[h] = 0
+}
+
+// first returns the index of the first pair with the name, -1 if none.
+func (q *Query) first(name []byte) int {
+	if !q.index.enabled {
+		for i := range q.pairs {
+			if bytes.Equal(q.pairs[i].name, name) {
+				return i
+			}
+		}
+		return -1
+	}
+
+	q.buildIndex()
+	slots := q.index.slots
+	mask := uint64(len(slots) - 1)
+	for h := hashQueryName(name) & mask; slots[h] != 0; h = (h + 1) & mask {
+		if i := int(slots[h] - 1); bytes.Equal(q.pairs[i].name, name) {
+			return i
+		}
+	}
+	return -1
+}
+
+// buildIndex indexes the pairs added after the last lookup, the table is
+// rebuilt if it is stale or too small.
+func (q *Query) buildIndex() {
+	x := &q.index
+
+	// Keep the load factor under 1/2
+	size := 16
+	for size < 2*len(q.pairs) {
+		size <<= 1
+	}
+	if len(x.slots) < size {
+		if cap(x.slots) >= size {
+			x.slots = x.slots[:size]
+		} else {
+			x.slots = make([]int32, size)
+		}
+		x.n = -1
+	}
+	if x.n < 0 || x.n > len(q.pairs) {
+		for i := range x.slots {
+			x.slots[i] = 0
+		}
+		x.n = 0
+	}
+
+	mask := uint64(len(x.slots) - 1)
+	for ; x.n < len(q.pairs); x.n++ {
+		name := q.pairs[x.n].name
+		h := hashQueryName(name) & mask
+		for ; x.slots[h] != 0; h = (h + 1) & mask {
+			if bytes.Equal(q.pairs[x.slots[h]-1].name, name) {
+				// The earlier pair is the first one
+				break
+			}
+		}
+		if x.slots[h] == 0 {
+			x.slots[h] = int32(x.n + 1)
+		}
+	}
+}
+
+// hashQueryName is the 64-bit FNV-1a.
+func hashQueryName(name []byte) uint64 {
+	h := uint64(14695981039346656037)
+	for _, c := range name {
+		h ^= uint64(c)
+		h *= 1099511628211
+	}
+	return h
+}
@@ -0,0 +1,135 @@
+package fasturl
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryIndex(t *testing.T) {
+	t.Run("test get set and del", func(t *testing.T) {
+		var q Query
+		q.EnableIndex()
+		require.Nil(t, q.Decode([]byte("a=1&b=2&a=3&c=4")))
+
+		v, ok := q.Get("a")
+		require.True(t, ok)
+		require.Equal(t, "1", string(v))
+
+		q.Add("d", "5")
+		v, ok = q.Get("d")
+		require.True(t, ok)
+		require.Equal(t, "5", string(v))
+
+		q.Set("a", "6")
+		require.Equal(t, "a=6&b=2&a=3&c=4&d=5", string(q.Encode(nil)))
+
+		q.Del("a")
+		v, ok = q.Get("a")
+		require.True(t, ok)
+		require.Equal(t, "3", string(v))
+		v, ok = q.Get("c")
+		require.True(t, ok)
+		require.Equal(t, "4", string(v))
+
+		q.Sort()
+		v, ok = q.Get("a")
+		require.True(t, ok)
+		require.Equal(t, "3", string(v))
+
+		q.Reset()
+		_, ok = q.Get("a")
+		require.False(t, ok)
+		require.Nil(t, q.Decode([]byte("x=1")))
+		v, ok = q.Get("x")
+		require.True(t, ok)
+		require.Equal(t, "1", string(v))
+	})
+
+	t.Run("test decode error", func(t *testing.T) {
+		var q Query
+		q.EnableIndex()
+		require.Nil(t, q.Decode([]byte("a=1")))
+		_, ok := q.Get("a")
+		require.True(t, ok)
+		require.NotNil(t, q.Decode([]byte("b=%zz")))
+		q.Add("c", "2")
+		_, ok = q.Get("b")
+		require.False(t, ok)
+		v, ok := q.Get("c")
+		require.True(t, ok)
+		require.Equal(t, "2", string(v))
+	})
+
+	t.Run("test del keeps the index", func(t *testing.T) {
+		var indexed, linear Query
+		indexed.EnableIndex()
+		for i := 0; i < 300; i++ {
+			name := fmt.Sprintf("k%d", i%40)
+			value := fmt.Sprintf("v%d", i)
+			indexed.Add(name, value)
+			linear.Add(name, value)
+		}
+		_, ok := indexed.Get("k0")
+		require.True(t, ok)
+
+		r := rand.New(rand.NewSource(1))
+		for len(linear.pairs) > 0 {
+			name := fmt.Sprintf("k%d", r.Intn(40))
+			indexed.Del(name)
+			linear.Del(name)
+			// The index is updated in place, not marked stale
+			require.Equal(t, len(indexed.pairs), indexed.index.n)
+
+			for i := 0; i < 40; i++ {
+				name := fmt.Sprintf("k%d", i)
+				a, aok := indexed.Get(name)
+				b, bok := linear.Get(name)
+				require.Equal(t, bok, aok, name)
+				require.Equal(t, string(b), string(a), name)
+			}
+		}
+	})
+
+	t.Run("test same as linear", func(t *testing.T) {
+		var indexed, linear Query
+		indexed.EnableIndex()
+
+		r := rand.New(rand.NewSource(1))
+		for i := 0; i < 20000; i++ {
+			name := fmt.Sprintf("k%d", r.Intn(200))
+			value := fmt.Sprintf("v%d", i)
+			switch r.Intn(8) {
+			case 0, 1:
+				indexed.Add(name, value)
+				linear.Add(name, value)
+			case 2:
+				indexed.Set(name, value)
+				linear.Set(name, value)
+			case 3:
+				indexed.Del(name)
+				linear.Del(name)
+			case 4:
+				indexed.DelAll(name)
+				linear.DelAll(name)
+			case 5:
+				if r.Intn(50) == 0 {
+					indexed.Sort()
+					linear.Sort()
+				}
+			default:
+				a, aok := indexed.Get(name)
+				b, bok := linear.Get(name)
+				require.Equal(t, bok, aok, name)
+				require.Equal(t, string(b), string(a), name)
+			}
+			if r.Intn(2000) == 0 {
+				indexed.Reset()
+				linear.Reset()
+			}
+		}
+		require.Equal(t, string(linear.Encode(nil)), string(indexed.Encode(nil)))
+	})
+}
@@ -0,0 +1,171 @@
+// Copyright 2009 The Go Authors.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//    * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//    * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//    * Neither the name of Google LLC nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+// The stable sort below is adapted from stable, insertionSort, symMerge,
+// rotate and swapRange of the Go sort package, src/sort/sort.go, to sort
+// the pairs without the sort.Interface, which moves the sorter to the heap.
+
+package fasturl
+
+// querySorter sorts the pairs by the stable in-place merge sort of the sort
+// package, which takes O(n*log(n)) comparisons and O(n*log(n)*log(n)) swaps
+// without allocating.
+type querySorter struct {
+	pairs []QueryPair
+	less  func(a, b *QueryPair) bool
+}
+
+func (s *querySorter) lessAt(i, j int) bool {
+	return s.less(&s.pairs[i], &s.pairs[j])
+}
+
+func (s *querySorter) swap(i, j int) {
+	s.pairs[i], s.pairs[j] = s.pairs[j], s.pairs[i]
+}
+
+// stable sorts the first n elements, the blocks of 20 are sorted by the
+// insertion sort and then merged.
+func (s *querySorter) stable(n int) {
+	blockSize := 20
+	a, b := 0, blockSize
+	for b <= n {
+		s.insertionSort(a, b)
+		a, b = b, b+blockSize
+	}
+	s.insertionSort(a, n)
+
+	for blockSize < n {
+		a, b = 0, 2*blockSize
+		for b <= n {
+			s.symMerge(a, a+blockSize, b)
+			a, b = b, b+2*blockSize
+		}
+		if m := a + blockSize; m < n {
+			s.symMerge(a, m, n)
+		}
+		blockSize *= 2
+	}
+}
+
+func (s *querySorter) insertionSort(a, b int) {
+	for i := a + 1; i < b; i++ {
+		for j := i; j > a && s.lessAt(j, j-1); j-- {
+			s.swap(j, j-1)
+		}
+	}
+}
+
+// symMerge merges the sorted [a, m) and [m, b) by the SymMerge algorithm of
+// Pok-Son Kim and Arne Kutzner.
+func (s *querySorter) symMerge(a, m, b int) {
+	if m-a == 1 {
+		// Insert the single element of [a, m) into [m, b)
+		i, j := m, b
+		for i < j {
+			h := int(uint(i+j) >> 1)
+			if s.lessAt(h, a) {
+				i = h + 1
+			} else {
+				j = h
+			}
+		}
+		for k := a; k < i-1; k++ {
+			s.swap(k, k+1)
+		}
+		return
+	}
+	if b-m == 1 {
+		// Insert the single element of [m, b) into [a, m)
+		i, j := a, m
+		for i < j {
+			h := int(uint(i+j) >> 1)
+			if !s.lessAt(m, h) {
+				i = h + 1
+			} else {
+				j = h
+			}
+		}
+		for k := m; k > i; k-- {
+			s.swap(k, k-1)
+		}
+		return
+	}
+
+	mid := int(uint(a+b) >> 1)
+	n := mid + m
+	var start, r int
+	if m > mid {
+		start = n - b
+		r = mid
+	} else {
+		start = a
+		r = m
+	}
+	p := n - 1
+	for start < r {
+		c := int(uint(start+r) >> 1)
+		if !s.lessAt(p-c, c) {
+			start = c + 1
+		} else {
+			r = c
+		}
+	}
+
+	end := n - start
+	if start < m && m < end {
+		s.rotate(start, m, end)
+	}
+	if a < start && start < mid {
+		s.symMerge(a, start, mid)
+	}
+	if mid < end && end < b {
+		s.symMerge(mid, end, b)
+	}
+}
+
+// rotate swaps the blocks [a, m) and [m, b).
+func (s *querySorter) rotate(a, m, b int) {
+	i := m - a
+	j := b - m
+	for i != j {
+		if i > j {
+			s.swapRange(m-i, m, j)
+			i -= j
+		} else {
+			s.swapRange(m-i, m+j-i, i)
+			j -= i
+		}
+	}
+	s.swapRange(m-i, m, i)
+}
+
+func (s *querySorter) swapRange(a, b, n int) {
+	for i := 0; i < n; i++ {
+		s.swap(a+i, b+i)
+	}
+}
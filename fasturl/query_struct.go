@@ -0,0 +1,569 @@
+package fasturl
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrQueryInvalidTarget indicates the target of UnmarshalQuery is not a
+	// non-nil pointer to struct.
+	ErrQueryInvalidTarget = errors.New("fasturl: UnmarshalQuery requires a non-nil pointer to struct")
+	// ErrQueryInvalidSource indicates the source of MarshalQuery is not a
+	// struct or a non-nil pointer to struct.
+	ErrQueryInvalidSource = errors.New("fasturl: MarshalQuery requires a struct or a non-nil pointer to struct")
+
+	textUnmarshalerType = reflect.TypeOf((*textUnmarshaler)(nil)).Elem()
+	textMarshalerType   = reflect.TypeOf((*textMarshaler)(nil)).Elem()
+	timeType            = reflect.TypeOf(time.Time{})
+	durationType        = reflect.TypeOf(time.Duration(0))
+)
+
+// textUnmarshaler is encoding.TextUnmarshaler, the package encoding is
+// shadowed by the type encoding.
+type textUnmarshaler interface {
+	UnmarshalText(text []byte) error
+}
+
+// textMarshaler is encoding.TextMarshaler.
+type textMarshaler interface {
+	MarshalText() (text []byte, err error)
+}
+
+// QueryFieldError describes the query value which can not be stored in the
+// field of the struct.
+type QueryFieldError struct {
+	// Name is the name of the query pair.
+	Name string
+	// Field is the name of the struct field, embedded fields are joined by '.'.
+	Field string
+	// Type is the type of the struct field.
+	Type reflect.Type
+	// Value is the value of the query pair.
+	Value string
+	// Err is the error of the conversion.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *QueryFieldError) Error() string {
+	return fmt.Sprintf("fasturl: cannot unmarshal query %s=%q into field %s of type %s: %v",
+		e.Name, e.Value, e.Field, e.Type, e.Err)
+}
+
+// Unwrap returns the error of the conversion.
+func (e *QueryFieldError) Unwrap() error {
+	return e.Err
+}
+
+// queryDecoder stores the value to v, layout is the layout of time.Time.
+type queryDecoder func(v reflect.Value, b []byte, layout string) error
+
+// queryEncoder appends the value v to dst, layout is the layout of
+// time.Time. v is not a pointer.
+type queryEncoder func(dst []byte, v reflect.Value, layout string) ([]byte, error)
+
+// queryField is the field of the struct bound to the query.
+type queryField struct {
+	name      string
+	field     string
+	index     []int
+	typ       reflect.Type
+	depth     int
+	omitEmpty bool
+	layout    string
+	// multi is set for the slices which hold the repeated pairs
+	multi bool
+	// tagged fields with the unsupported type are reported when they are used
+	tagged bool
+	decode queryDecoder
+	encode queryEncoder
+}
+
+// queryPlan is the cached fields of the struct type.
+type queryPlan struct {
+	fields []queryField
+	err    error
+}
+
+// queryPlans caches the plans by the struct type.
+var queryPlans sync.Map // map[reflect.Type]*queryPlan
+
+// UnmarshalQuery stores the query pairs to the struct which v points to.
+//
+// The field is bound to the pair named by its tag, or its name without a tag:
+//
+//	Page    int       `query:"page"`
+//	Tags    []string  `query:"tag"`          // all the pairs named tag
+//	Since   time.Time `query:"since" layout:"2006-01-02"`
+//	Ignored string    `query:"-"`
+//
+// The fields of the embedded structs are bound like the fields of the struct.
+// The supported types are string, []byte, bool, the ints, the uints, the
+// floats, time.Time (RFC 3339 by default), time.Duration, the
+// encoding.TextUnmarshaler, the pointers to them and the slices of them. The
+// fields without pair or with an empty value are left as they are, the
+// first value which can not be converted is reported as *QueryFieldError.
+func UnmarshalQuery(q *Query, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return ErrQueryInvalidTarget
+	}
+	rv = rv.Elem()
+
+	plan := cachedQueryPlan(rv.Type())
+	if plan.err != nil {
+		return plan.err
+	}
+
+	for i := range plan.fields {
+		f := &plan.fields[i]
+		if f.decode == nil {
+			if f.tagged {
+				return f.unsupported()
+			}
+			continue
+		}
+		if f.multi {
+			if err := f.decodeAll(q, rv); err != nil {
+				return err
+			}
+			continue
+		}
+
+		b, ok := q.GetBytes(s2b(f.name))
+		if !ok || len(b) == 0 {
+			continue
+		}
+		if err := f.decode(queryFieldByIndex(rv, f.index), b, f.layout); err != nil {
+			return f.error(b, err)
+		}
+	}
+	return nil
+}
+
+// decodeAll stores all the pairs with the name to the slice.
+func (f *queryField) decodeAll(q *Query, rv reflect.Value) error {
+	if q.first(s2b(f.name)) < 0 {
+		return nil
+	}
+
+	s := queryFieldByIndex(rv, f.index)
+	s.Set(s.Slice(0, 0))
+	var err error
+	q.GetAllBytes(s2b(f.name), func(b []byte) bool {
+		n := s.Len()
+		s.Set(reflect.Append(s, reflect.Zero(f.typ.Elem())))
+		if len(b) == 0 {
+			return true
+		}
+		if e := f.decode(s.Index(n), b, f.layout); e != nil {
+			err = f.error(b, e)
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// MarshalQuery adds the pairs of the struct which v is or points to, to the
+// query.
+//
+// The fields are bound like UnmarshalQuery, the slices add one pair per
+// element and encoding.TextMarshaler is supported instead of
+// encoding.TextUnmarshaler. The fields with the omitempty option and the
+// zero value and the nil pointers are skipped:
+//
+//	Cursor string    `query:"cursor,omitempty"`
+//	Tags   []string  `query:"tag"`          // tag=a&tag=b
+//	Since  time.Time `query:"since" layout:"2006-01-02"`
+func MarshalQuery(q *Query, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		rv = rv.Elem()
+	} else if rv.Kind() == reflect.Struct {
+		// The methods of the pointer need an addressable value
+		p := reflect.New(rv.Type()).Elem()
+		p.Set(rv)
+		rv = p
+	}
+	if rv.Kind() != reflect.Struct {
+		return ErrQueryInvalidSource
+	}
+
+	plan := cachedQueryPlan(rv.Type())
+	if plan.err != nil {
+		return plan.err
+	}
+
+	var buf []byte
+	for i := range plan.fields {
+		f := &plan.fields[i]
+		if f.encode == nil {
+			if f.tagged {
+				return f.unsupported()
+			}
+			continue
+		}
+
+		fv, ok := queryFieldValue(rv, f.index)
+		if !ok || f.omitEmpty && fv.IsZero() {
+			continue
+		}
+		if !f.multi {
+			var err error
+			if buf, err = f.encodeValue(q, buf, fv); err != nil {
+				return err
+			}
+			continue
+		}
+		for j := 0; j < fv.Len(); j++ {
+			var err error
+			if buf, err = f.encodeValue(q, buf, fv.Index(j)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// encodeValue adds the pair of the value, buf is the scratch.
+func (f *queryField) encodeValue(q *Query, buf []byte, v reflect.Value) ([]byte, error) {
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return buf, nil
+		}
+		v = v.Elem()
+	}
+	buf, err := f.encode(buf[:0], v, f.layout)
+	if err != nil {
+		return buf, fmt.Errorf("fasturl: cannot marshal field %s of type %s: %w", f.field, f.typ, err)
+	}
+	q.AddBytes(s2b(f.name), buf)
+	return buf, nil
+}
+
+func (f *queryField) error(b []byte, err error) error {
+	// The errors of strconv hold the input, which shares the buffer of the
+	// query
+	if ne, ok := err.(*strconv.NumError); ok {
+		err = ne.Err
+	}
+	return &QueryFieldError{
+		Name:  f.name,
+		Field: f.field,
+		Type:  f.typ,
+		Value: string(b),
+		Err:   err,
+	}
+}
+
+func (f *queryField) unsupported() error {
+	return fmt.Errorf("fasturl: unsupported type %s of field %s", f.typ, f.field)
+}
+
+// queryFieldByIndex returns the field, allocating the nil embedded pointers.
+func queryFieldByIndex(v reflect.Value, index []int) reflect.Value {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v
+}
+
+// queryFieldValue returns the field, false if an embedded pointer is nil.
+func queryFieldValue(v reflect.Value, index []int) (reflect.Value, bool) {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return v, false
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v, true
+}
+
+func cachedQueryPlan(t reflect.Type) *queryPlan {
+	if p, ok := queryPlans.Load(t); ok {
+		return p.(*queryPlan)
+	}
+	p, _ := queryPlans.LoadOrStore(t, newQueryPlan(t))
+	return p.(*queryPlan)
+}
+
+func newQueryPlan(t reflect.Type) *queryPlan {
+	var fields []queryField
+	visited := map[reflect.Type]bool{t: true}
+	if err := collectQueryFields(&fields, t, nil, "", visited); err != nil {
+		return &queryPlan{err: err}
+	}
+
+	// The shallower field hides the deeper one with the same name
+	plan := &queryPlan{}
+	for i := range fields {
+		hidden := false
+		for j := range fields {
+			if fields[j].name == fields[i].name &&
+				(fields[j].depth < fields[i].depth || fields[j].depth == fields[i].depth && j < i) {
+				hidden = true
+				break
+			}
+		}
+		if !hidden {
+			plan.fields = append(plan.fields, fields[i])
+		}
+	}
+	return plan
+}
+
+// collectQueryFields collects the fields of t and of its embedded structs,
+// visited holds the structs being collected, the fields of the struct
+// embedded in itself are hidden by the shallower ones, so it is not walked
+// again.
+func collectQueryFields(fields *[]queryField, t reflect.Type, index []int, prefix string,
+	visited map[reflect.Type]bool) error {
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag, tagged := sf.Tag.Lookup("query")
+		if tag == "-" {
+			continue
+		}
+		name, opts := tag, ""
+		if j := strings.IndexByte(tag, ','); j >= 0 {
+			name, opts = tag[:j], tag[j+1:]
+		}
+
+		fi := make([]int, len(index)+1)
+		copy(fi, index)
+		fi[len(index)] = i
+
+		ft := sf.Type
+		if sf.Anonymous && name == "" {
+			et := ft
+			if et.Kind() == reflect.Ptr {
+				et = et.Elem()
+			}
+			// The unexported embedded pointer can not be allocated
+			if et.Kind() == reflect.Struct && et != timeType &&
+				(sf.PkgPath == "" || ft.Kind() != reflect.Ptr) {
+				if !visited[et] {
+					visited[et] = true
+					err := collectQueryFields(fields, et, fi, prefix+sf.Name+".", visited)
+					delete(visited, et)
+					if err != nil {
+						return err
+					}
+				}
+				continue
+			}
+		}
+		if sf.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = sf.Name
+		}
+
+		f := queryField{
+			name:   name,
+			field:  prefix + sf.Name,
+			index:  fi,
+			typ:    ft,
+			depth:  len(index),
+			layout: sf.Tag.Get("layout"),
+		}
+		for _, opt := range strings.Split(opts, ",") {
+			if opt == "omitempty" {
+				f.omitEmpty = true
+			}
+		}
+		if f.layout == "" {
+			f.layout = time.RFC3339
+		}
+
+		dt := ft
+		if ft.Kind() == reflect.Slice && ft.Elem().Kind() != reflect.Uint8 &&
+			!reflect.PtrTo(ft).Implements(textUnmarshalerType) &&
+			!reflect.PtrTo(ft).Implements(textMarshalerType) {
+			f.multi = true
+			dt = ft.Elem()
+		}
+		f.decode = newQueryDecoder(dt)
+		f.encode = newQueryEncoder(dt)
+		if f.decode == nil && f.encode == nil {
+			if tagged {
+				return f.unsupported()
+			}
+			// The untagged field may be used for something else
+			continue
+		}
+		f.tagged = tagged
+		*fields = append(*fields, f)
+	}
+	return nil
+}
+
+// newQueryDecoder returns the decoder of the type, nil if it is unsupported.
+func newQueryDecoder(t reflect.Type) queryDecoder {
+	switch t {
+	case timeType:
+		return func(v reflect.Value, b []byte, layout string) error {
+			// The zone name of Time may refer to the input
+			tm, err := time.Parse(layout, string(b))
+			if err != nil {
+				return err
+			}
+			v.Set(reflect.ValueOf(tm))
+			return nil
+		}
+	case durationType:
+		return func(v reflect.Value, b []byte, layout string) error {
+			d, err := time.ParseDuration(b2s(b))
+			if err != nil {
+				return err
+			}
+			v.SetInt(int64(d))
+			return nil
+		}
+	}
+
+	if t.Kind() != reflect.Ptr && reflect.PtrTo(t).Implements(textUnmarshalerType) {
+		return func(v reflect.Value, b []byte, layout string) error {
+			return v.Addr().Interface().(textUnmarshaler).UnmarshalText(b)
+		}
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		elem := newQueryDecoder(t.Elem())
+		if elem == nil {
+			return nil
+		}
+		return func(v reflect.Value, b []byte, layout string) error {
+			if v.IsNil() {
+				v.Set(reflect.New(t.Elem()))
+			}
+			return elem(v.Elem(), b, layout)
+		}
+	case reflect.String:
+		return func(v reflect.Value, b []byte, layout string) error {
+			v.SetString(string(b))
+			return nil
+		}
+	case reflect.Slice:
+		if t.Elem().Kind() != reflect.Uint8 {
+			return nil
+		}
+		return func(v reflect.Value, b []byte, layout string) error {
+			v.SetBytes(append(v.Bytes()[:0], b...))
+			return nil
+		}
+	case reflect.Bool:
+		return func(v reflect.Value, b []byte, layout string) error {
+			x, err := strconv.ParseBool(b2s(b))
+			if err != nil {
+				return err
+			}
+			v.SetBool(x)
+			return nil
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return func(v reflect.Value, b []byte, layout string) error {
+			x, err := strconv.ParseInt(b2s(b), 10, t.Bits())
+			if err != nil {
+				return err
+			}
+			v.SetInt(x)
+			return nil
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return func(v reflect.Value, b []byte, layout string) error {
+			x, err := strconv.ParseUint(b2s(b), 10, t.Bits())
+			if err != nil {
+				return err
+			}
+			v.SetUint(x)
+			return nil
+		}
+	case reflect.Float32, reflect.Float64:
+		return func(v reflect.Value, b []byte, layout string) error {
+			x, err := strconv.ParseFloat(b2s(b), t.Bits())
+			if err != nil {
+				return err
+			}
+			v.SetFloat(x)
+			return nil
+		}
+	}
+	return nil
+}
+
+// newQueryEncoder returns the encoder of the type, nil if it is unsupported.
+// The encoder of the pointer type encodes the value it points to.
+func newQueryEncoder(t reflect.Type) queryEncoder {
+	switch t {
+	case timeType:
+		return func(dst []byte, v reflect.Value, layout string) ([]byte, error) {
+			return v.Interface().(time.Time).AppendFormat(dst, layout), nil
+		}
+	case durationType:
+		return func(dst []byte, v reflect.Value, layout string) ([]byte, error) {
+			return append(dst, time.Duration(v.Int()).String()...), nil
+		}
+	}
+
+	if t.Kind() != reflect.Ptr && (t.Implements(textMarshalerType) || reflect.PtrTo(t).Implements(textMarshalerType)) {
+		return func(dst []byte, v reflect.Value, layout string) ([]byte, error) {
+			if !t.Implements(textMarshalerType) {
+				v = v.Addr()
+			}
+			b, err := v.Interface().(textMarshaler).MarshalText()
+			return append(dst, b...), err
+		}
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		return newQueryEncoder(t.Elem())
+	case reflect.String:
+		return func(dst []byte, v reflect.Value, layout string) ([]byte, error) {
+			return append(dst, v.String()...), nil
+		}
+	case reflect.Slice:
+		if t.Elem().Kind() != reflect.Uint8 {
+			return nil
+		}
+		return func(dst []byte, v reflect.Value, layout string) ([]byte, error) {
+			return append(dst, v.Bytes()...), nil
+		}
+	case reflect.Bool:
+		return func(dst []byte, v reflect.Value, layout string) ([]byte, error) {
+			return strconv.AppendBool(dst, v.Bool()), nil
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return func(dst []byte, v reflect.Value, layout string) ([]byte, error) {
+			return strconv.AppendInt(dst, v.Int(), 10), nil
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return func(dst []byte, v reflect.Value, layout string) ([]byte, error) {
+			return strconv.AppendUint(dst, v.Uint(), 10), nil
+		}
+	case reflect.Float32, reflect.Float64:
+		return func(dst []byte, v reflect.Value, layout string) ([]byte, error) {
+			return strconv.AppendFloat(dst, v.Float(), 'g', -1, t.Bits()), nil
+		}
+	}
+	return nil
+}
@@ -0,0 +1,267 @@
+package fasturl
+
+import (
+	"errors"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type queryPage struct {
+	Page int  `query:"page"`
+	Size *int `query:"size,omitempty"`
+}
+
+type QueryFilter struct {
+	Tags    []string `query:"tag"`
+	Score   float64  `query:"score"`
+	Verbose bool
+}
+
+type querySearch struct {
+	queryPage
+	*QueryFilter
+	Q        string        `query:"q"`
+	Raw      []byte        `query:"raw"`
+	Offset   uint16        `query:"offset"`
+	Delta    int8          `query:"delta"`
+	Ratio    float32       `query:"ratio"`
+	Since    time.Time     `query:"since" layout:"2006-01-02"`
+	At       *time.Time    `query:"at"`
+	Timeout  time.Duration `query:"timeout"`
+	IP       net.IP        `query:"ip"`
+	IDs      []int64       `query:"id"`
+	Ignored  string        `query:"-"`
+	Page     string        `query:"p"`
+	Callback func()
+	private  string
+}
+
+func TestUnmarshalQuery(t *testing.T) {
+	var q Query
+	require.Nil(t, q.Decode([]byte("q=go+url&raw=a%00b&page=2&size=20&tag=a&tag=b&score=0.5&Verbose=true"+
+		"&offset=65535&delta=-8&ratio=1.5&since=2020-01-02&at=2020-01-02T03:04:05Z&timeout=1m30s"+
+		"&ip=127.0.0.1&id=1&id=2&id=3&Ignored=x&p=first&private=x")))
+
+	var s querySearch
+	s.Ignored = "keep"
+	require.Nil(t, UnmarshalQuery(&q, &s))
+
+	require.Equal(t, "go url", s.Q)
+	require.Equal(t, []byte("a\x00b"), s.Raw)
+	require.Equal(t, 2, s.queryPage.Page)
+	require.Equal(t, 20, *s.Size)
+	require.Equal(t, []string{"a", "b"}, s.Tags)
+	require.Equal(t, 0.5, s.Score)
+	require.True(t, s.Verbose)
+	require.Equal(t, uint16(65535), s.Offset)
+	require.Equal(t, int8(-8), s.Delta)
+	require.Equal(t, float32(1.5), s.Ratio)
+	require.Equal(t, time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC), s.Since)
+	require.Equal(t, time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC), *s.At)
+	require.Equal(t, 90*time.Second, s.Timeout)
+	require.Equal(t, "127.0.0.1", s.IP.String())
+	require.Equal(t, []int64{1, 2, 3}, s.IDs)
+	require.Equal(t, "keep", s.Ignored)
+	require.Equal(t, "first", s.Page)
+	require.Equal(t, "", s.private)
+}
+
+func TestUnmarshalQueryMissing(t *testing.T) {
+	var q Query
+	require.Nil(t, q.Decode([]byte("page=&tag=&tag=x")))
+
+	s := querySearch{Q: "keep", IDs: []int64{1}}
+	require.Nil(t, UnmarshalQuery(&q, &s))
+	require.Equal(t, "keep", s.Q)
+	require.Equal(t, 0, s.queryPage.Page)
+	require.Nil(t, s.Size)
+	require.Equal(t, []string{"", "x"}, s.Tags)
+	require.Equal(t, []int64{1}, s.IDs)
+
+	// The unexported embedded pointer is skipped
+	var p struct {
+		*queryPage
+	}
+	require.Nil(t, UnmarshalQuery(&q, &p))
+	require.Nil(t, p.queryPage)
+}
+
+func TestUnmarshalQueryError(t *testing.T) {
+	for _, tt := range []struct {
+		input string
+		name  string
+		field string
+		err   error
+	}{
+		{"page=x", "page", "queryPage.Page", strconv.ErrSyntax},
+		{"offset=65536", "offset", "Offset", strconv.ErrRange},
+		{"delta=128", "delta", "Delta", strconv.ErrRange},
+		{"id=1&id=x", "id", "IDs", strconv.ErrSyntax},
+		{"Verbose=yes", "Verbose", "QueryFilter.Verbose", strconv.ErrSyntax},
+		{"since=2020", "since", "Since", nil},
+		{"timeout=1x", "timeout", "Timeout", nil},
+		{"ip=x", "ip", "IP", nil},
+	} {
+		var q Query
+		require.Nil(t, q.Decode([]byte(tt.input)), tt.input)
+
+		var s querySearch
+		err := UnmarshalQuery(&q, &s)
+		var fe *QueryFieldError
+		require.True(t, errors.As(err, &fe), tt.input)
+		require.Equal(t, tt.name, fe.Name, tt.input)
+		require.Equal(t, tt.field, fe.Field, tt.input)
+		if tt.err != nil {
+			require.True(t, errors.Is(err, tt.err), tt.input)
+		}
+	}
+
+	var q Query
+	var s querySearch
+	require.Equal(t, ErrQueryInvalidTarget, UnmarshalQuery(&q, s))
+	require.Equal(t, ErrQueryInvalidTarget, UnmarshalQuery(&q, (*querySearch)(nil)))
+	require.Equal(t, ErrQueryInvalidTarget, UnmarshalQuery(&q, new(int)))
+
+	var bad struct {
+		M map[string]string `query:"m"`
+	}
+	require.NotNil(t, UnmarshalQuery(&q, &bad))
+}
+
+func TestUnmarshalQueryErrorCopiesValue(t *testing.T) {
+	var q Query
+	require.Nil(t, q.Decode([]byte("page=abc")))
+
+	var s querySearch
+	err := UnmarshalQuery(&q, &s)
+	require.NotNil(t, err)
+	msg := err.Error()
+
+	q.Reset()
+	require.Nil(t, q.Decode([]byte("page=xyz")))
+	require.Equal(t, msg, err.Error())
+	require.Contains(t, msg, `"abc"`)
+}
+
+type queryLevel int
+
+func (l queryLevel) MarshalText() ([]byte, error) {
+	if l < 0 {
+		return nil, errors.New("negative level")
+	}
+	return []byte("L" + strconv.Itoa(int(l))), nil
+}
+
+type queryReport struct {
+	Level  queryLevel    `query:"level"`
+	Levels []*queryLevel `query:"lv"`
+	Cursor string        `query:"cursor,omitempty"`
+	Limit  int           `query:"limit,omitempty"`
+	Empty  string        `query:"empty"`
+}
+
+func TestMarshalQuery(t *testing.T) {
+	size := 20
+	at := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	s := querySearch{
+		queryPage:   queryPage{Page: 2, Size: &size},
+		QueryFilter: &QueryFilter{Tags: []string{"a", "b"}, Score: 0.5, Verbose: true},
+		Q:           "go url",
+		Raw:         []byte("a\x00b"),
+		Offset:      65535,
+		Delta:       -8,
+		Ratio:       1.5,
+		Since:       time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+		At:          &at,
+		Timeout:     90 * time.Second,
+		IP:          net.IPv4(127, 0, 0, 1),
+		IDs:         []int64{1, 2, 3},
+		Ignored:     "x",
+		Page:        "first",
+	}
+
+	var q Query
+	require.Nil(t, MarshalQuery(&q, &s))
+	require.Equal(t, "page=2&size=20&tag=a&tag=b&score=0.5&Verbose=true&q=go+url&raw=a%00b"+
+		"&offset=65535&delta=-8&ratio=1.5&since=2020-01-02&at=2020-01-02T03%3A04%3A05Z"+
+		"&timeout=1m30s&ip=127.0.0.1&id=1&id=2&id=3&p=first", string(q.Encode(nil)))
+
+	var d querySearch
+	require.Nil(t, UnmarshalQuery(&q, &d))
+	d.Ignored = s.Ignored
+	require.Equal(t, s.Since, d.Since)
+	require.True(t, s.IP.Equal(d.IP))
+	d.Since, d.IP = s.Since, s.IP
+	require.Equal(t, s, d)
+
+	// The nil pointers are skipped, the struct may be passed by value
+	q.Reset()
+	require.Nil(t, MarshalQuery(&q, querySearch{Q: "x"}))
+	require.Equal(t, "page=0&q=x&raw=&offset=0&delta=0&ratio=0&since=0001-01-01&timeout=0s&ip=&p=",
+		string(q.Encode(nil)))
+}
+
+type QueryNode struct {
+	*QueryNode
+	A int `query:"a"`
+}
+
+type queryTree struct {
+	QueryNode
+	B int `query:"b"`
+}
+
+func TestMarshalQueryRecursive(t *testing.T) {
+	var q Query
+	require.Nil(t, MarshalQuery(&q, &QueryNode{A: 1, QueryNode: &QueryNode{A: 2}}))
+	require.Equal(t, "a=1", string(q.Encode(nil)))
+
+	var n QueryNode
+	require.Nil(t, UnmarshalQuery(&q, &n))
+	require.Equal(t, QueryNode{A: 1}, n)
+
+	q.Reset()
+	require.Nil(t, MarshalQuery(&q, &queryTree{QueryNode: QueryNode{A: 1}, B: 2}))
+	require.Equal(t, "a=1&b=2", string(q.Encode(nil)))
+
+	var tr queryTree
+	require.Nil(t, UnmarshalQuery(&q, &tr))
+	require.Equal(t, queryTree{QueryNode: QueryNode{A: 1}, B: 2}, tr)
+}
+
+func TestMarshalQueryOmitEmpty(t *testing.T) {
+	one, two := queryLevel(1), queryLevel(2)
+
+	var q Query
+	q.Add("keep", "1")
+	require.Nil(t, MarshalQuery(&q, queryReport{Level: 3, Levels: []*queryLevel{&one, nil, &two}}))
+	require.Equal(t, "keep=1&level=L3&lv=L1&lv=L2&empty=", string(q.Encode(nil)))
+
+	q.Reset()
+	require.Nil(t, MarshalQuery(&q, &queryReport{Cursor: "c", Limit: 10}))
+	require.Equal(t, "level=L0&cursor=c&limit=10&empty=", string(q.Encode(nil)))
+
+	// queryLevel implements no encoding.TextUnmarshaler
+	var r queryReport
+	require.NotNil(t, UnmarshalQuery(&q, &r))
+}
+
+func TestMarshalQueryError(t *testing.T) {
+	var q Query
+	err := MarshalQuery(&q, queryReport{Level: -1})
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "negative level")
+	require.Contains(t, err.Error(), "Level")
+
+	require.Equal(t, ErrQueryInvalidSource, MarshalQuery(&q, (*querySearch)(nil)))
+	require.Equal(t, ErrQueryInvalidSource, MarshalQuery(&q, 1))
+
+	var bad struct {
+		M map[string]string `query:"m"`
+	}
+	require.NotNil(t, MarshalQuery(&q, bad))
+}
@@ -1,6 +1,10 @@
 package fasturl
 
 import (
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -47,6 +51,29 @@ func TestQuery(t *testing.T) {
 		require.Equal(t, 0, len(values))
 	})
 
+	t.Run("test del and add", func(t *testing.T) {
+		var q Query
+		q.Add("a", "1")
+		q.Add("b", "2")
+		q.Add("c", "3")
+		q.Del("a")
+		// The buffers of the removed pair must not be shared with "c"
+		q.Add("d", "4")
+
+		v, ok := q.Get("c")
+		require.True(t, ok)
+		require.Equal(t, "3", string(v))
+		v, ok = q.Get("d")
+		require.True(t, ok)
+		require.Equal(t, "4", string(v))
+		require.Equal(t, "b=2&c=3&d=4", string(q.Encode(nil)))
+
+		q.Del("b")
+		q.Reset()
+		require.Nil(t, q.Decode([]byte("x=1&y=2&z=3&w=4")))
+		require.Equal(t, "x=1&y=2&z=3&w=4", string(q.Encode(nil)))
+	})
+
 	t.Run("test range and reset", func(t *testing.T) {
 		var q Query
 		q.AddBytes([]byte("abcd"), []byte("defg"))
@@ -96,4 +123,93 @@ func TestQuery(t *testing.T) {
 		dd = q.Encode(dd)
 		require.Equal(t, string(input), string(dd))
 	})
+
+	t.Run("test sort", func(t *testing.T) {
+		for _, tt := range []struct {
+			input  string
+			expect string
+		}{
+			{"", ""},
+			{"a=1", "a=1"},
+			{"b=2&a=1", "a=1&b=2"},
+			{"a=2&b=1&a=1&a=2", "a=1&a=2&a=2&b=1"},
+			{"ab=1&a=2&B=3&=4", "=4&B=3&a=2&ab=1"},
+			{"c=%20&c=+&c=", "c=&c=+&c=+"},
+		} {
+			var q Query
+			require.Nil(t, q.Decode([]byte(tt.input)), tt.input)
+			before := string(q.Encode(nil))
+
+			require.Equal(t, tt.expect, string(q.EncodeSorted(nil)), tt.input)
+			require.Equal(t, before, string(q.Encode(nil)), tt.input)
+
+			q.Sort()
+			require.Equal(t, tt.expect, string(q.Encode(nil)), tt.input)
+		}
+	})
+
+	t.Run("test sort func", func(t *testing.T) {
+		var q Query
+		require.Nil(t, q.Decode([]byte("b=2&a=3&b=1&a=1&a=2")))
+		q.SortFunc(func(a, b *QueryPair) bool {
+			return string(a.Name()) < string(b.Name())
+		})
+		require.Equal(t, "a=3&a=1&a=2&b=2&b=1", string(q.Encode(nil)))
+
+		q.SortFunc(func(a, b *QueryPair) bool {
+			return string(a.Value()) > string(b.Value())
+		})
+		require.Equal(t, "a=3&a=2&b=2&a=1&b=1", string(q.Encode(nil)))
+	})
+
+	t.Run("test sort many pairs", func(t *testing.T) {
+		r := rand.New(rand.NewSource(1))
+		for _, n := range []int{0, 1, 19, 20, 21, 40, 63, 100, 1000} {
+			pairs := make([]string, n)
+			for i := range pairs {
+				pairs[i] = strconv.Itoa(r.Intn(8)) + "=" + strconv.Itoa(r.Intn(8))
+			}
+			var q Query
+			require.Nil(t, q.Decode([]byte(strings.Join(pairs, "&"))))
+
+			byName := append([]string(nil), pairs...)
+			sort.SliceStable(byName, func(i, j int) bool {
+				return byName[i][0] < byName[j][0]
+			})
+			sort.Strings(pairs)
+			require.Equal(t, strings.Join(pairs, "&"), string(q.EncodeSorted(nil)), n)
+
+			q.SortFunc(func(a, b *QueryPair) bool {
+				return string(a.Name()) < string(b.Name())
+			})
+			require.Equal(t, strings.Join(byName, "&"), string(q.Encode(nil)), n)
+
+			var dst []byte
+			allocs := testing.AllocsPerRun(10, func() {
+				dst = q.EncodeSorted(dst[:0])
+				q.Sort()
+			})
+			require.Equal(t, float64(0), allocs, n)
+		}
+	})
+
+	t.Run("test encode sorted keeps the pairs", func(t *testing.T) {
+		var q Query
+		q.EnableIndex()
+		require.Nil(t, q.Decode([]byte("c=1&a=2&b=3&a=1&c=0")))
+		_, ok := q.Get("b")
+		require.True(t, ok)
+		q.Add("a", "0")
+
+		dst := make([]byte, 0, 64)
+		allocs := testing.AllocsPerRun(10, func() {
+			dst = q.EncodeSorted(dst[:0])
+		})
+		require.Equal(t, float64(0), allocs)
+		require.Equal(t, "a=0&a=1&a=2&b=3&c=0&c=1", string(dst))
+		require.Equal(t, "c=1&a=2&b=3&a=1&c=0&a=0", string(q.Encode(nil)))
+		v, ok := q.Get("a")
+		require.True(t, ok)
+		require.Equal(t, "2", string(v))
+	})
 }
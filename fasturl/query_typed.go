@@ -0,0 +1,446 @@
+package fasturl
+
+import (
+	"errors"
+	"strconv"
+	"time"
+)
+
+var (
+	// ErrQueryMissing indicates the query has no pair with the name.
+	ErrQueryMissing = errors.New("fasturl: query value missing")
+	// ErrQueryMalformed indicates the value can not be parsed as the type,
+	// the empty value is malformed.
+	ErrQueryMalformed = errors.New("fasturl: query value malformed")
+	// ErrQueryOutOfRange indicates the value is out of the range of the type.
+	ErrQueryOutOfRange = errors.New("fasturl: query value out of range")
+)
+
+// GetInt parses the first value of the name as a decimal int64 with an
+// optional sign.
+func (q *Query) GetInt(name string) (int64, error) {
+	b, ok := q.GetBytes(s2b(name))
+	if !ok {
+		return 0, ErrQueryMissing
+	}
+	neg := false
+	if len(b) > 0 && (b[0] == '-' || b[0] == '+') {
+		neg = b[0] == '-'
+		b = b[1:]
+	}
+	x, err := parseQueryUint(b)
+	if err != nil {
+		return 0, err
+	}
+	if neg {
+		if x > 1<<63 {
+			return 0, ErrQueryOutOfRange
+		}
+		return -int64(x), nil
+	}
+	if x > 1<<63-1 {
+		return 0, ErrQueryOutOfRange
+	}
+	return int64(x), nil
+}
+
+// GetUint parses the first value of the name as a decimal uint64.
+func (q *Query) GetUint(name string) (uint64, error) {
+	b, ok := q.GetBytes(s2b(name))
+	if !ok {
+		return 0, ErrQueryMissing
+	}
+	return parseQueryUint(b)
+}
+
+// parseQueryUint parses the decimal digits by hand, the errors of strconv
+// allocate.
+func parseQueryUint(b []byte) (uint64, error) {
+	if len(b) == 0 {
+		return 0, ErrQueryMalformed
+	}
+	var x uint64
+	overflow := false
+	for i := 0; i < len(b); i++ {
+		c := b[i]
+		if c < '0' || c > '9' {
+			return 0, ErrQueryMalformed
+		}
+		d := uint64(c - '0')
+		if x > (1<<64-1-d)/10 {
+			overflow = true
+		}
+		x = x*10 + d
+	}
+	if overflow {
+		return 0, ErrQueryOutOfRange
+	}
+	return x, nil
+}
+
+// GetFloat parses the first value of the name as a decimal float64, inf,
+// infinity and nan are accepted case-insensitively.
+func (q *Query) GetFloat(name string) (float64, error) {
+	b, ok := q.GetBytes(s2b(name))
+	if !ok {
+		return 0, ErrQueryMissing
+	}
+	if !isQueryFloat(b) {
+		return 0, ErrQueryMalformed
+	}
+	// The syntax is checked, so strconv fails only if it is out of range
+	x, err := strconv.ParseFloat(b2s(b), 64)
+	if err != nil {
+		return 0, ErrQueryOutOfRange
+	}
+	return x, nil
+}
+
+// isQueryFloat reports whether b is a decimal float:
+//
+//	float = [ "+" / "-" ] ( mantissa [ exponent ] / "inf" / "infinity" / "nan" )
+//	mantissa = 1*DIGIT [ "." *DIGIT ] / "." 1*DIGIT
+//	exponent = ( "e" / "E" ) [ "+" / "-" ] 1*DIGIT
+func isQueryFloat(b []byte) bool {
+	if len(b) > 0 && (b[0] == '-' || b[0] == '+') {
+		b = b[1:]
+	}
+	switch {
+	case equalFoldASCII(b, "inf"), equalFoldASCII(b, "infinity"), equalFoldASCII(b, "nan"):
+		return true
+	}
+
+	i, digits := 0, 0
+	for ; i < len(b) && '0' <= b[i] && b[i] <= '9'; i++ {
+		digits++
+	}
+	if i < len(b) && b[i] == '.' {
+		for i++; i < len(b) && '0' <= b[i] && b[i] <= '9'; i++ {
+			digits++
+		}
+	}
+	if digits == 0 {
+		return false
+	}
+	if i < len(b) && (b[i] == 'e' || b[i] == 'E') {
+		i++
+		if i < len(b) && (b[i] == '-' || b[i] == '+') {
+			i++
+		}
+		n := i
+		for ; i < len(b) && '0' <= b[i] && b[i] <= '9'; i++ {
+		}
+		if i == n {
+			return false
+		}
+	}
+	return i == len(b)
+}
+
+// equalFoldASCII reports whether b equals the lowercase s ignoring the ASCII
+// case.
+func equalFoldASCII(b []byte, s string) bool {
+	if len(b) != len(s) {
+		return false
+	}
+	for i := 0; i < len(b); i++ {
+		if b[i]|0x20 != s[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// GetBool parses the first value of the name like strconv.ParseBool.
+func (q *Query) GetBool(name string) (bool, error) {
+	b, ok := q.GetBytes(s2b(name))
+	if !ok {
+		return false, ErrQueryMissing
+	}
+	switch b2s(b) {
+	case "1", "t", "T", "true", "TRUE", "True":
+		return true, nil
+	case "0", "f", "F", "false", "FALSE", "False":
+		return false, nil
+	}
+	return false, ErrQueryMalformed
+}
+
+// GetDuration parses the first value of the name like time.ParseDuration.
+func (q *Query) GetDuration(name string) (time.Duration, error) {
+	b, ok := q.GetBytes(s2b(name))
+	if !ok {
+		return 0, ErrQueryMissing
+	}
+	return parseQueryDuration(b)
+}
+
+// parseQueryDuration parses the duration like time.ParseDuration by hand,
+// the errors of time allocate:
+//
+//	duration = [ "+" / "-" ] ( "0" / 1*( number unit ) )
+//	number = 1*DIGIT [ "." *DIGIT ] / "." 1*DIGIT
+//	unit = "ns" / "us" / "µs" / "μs" / "ms" / "s" / "m" / "h"
+func parseQueryDuration(b []byte) (time.Duration, error) {
+	neg := false
+	if len(b) > 0 && (b[0] == '-' || b[0] == '+') {
+		neg = b[0] == '-'
+		b = b[1:]
+	}
+	if b2s(b) == "0" {
+		return 0, nil
+	}
+	if len(b) == 0 {
+		return 0, ErrQueryMalformed
+	}
+
+	var d uint64
+	for len(b) > 0 {
+		// The integer part
+		var v uint64
+		i := 0
+		for ; i < len(b) && '0' <= b[i] && b[i] <= '9'; i++ {
+			if v > 1<<63/10 {
+				return 0, ErrQueryOutOfRange
+			}
+			v = v*10 + uint64(b[i]-'0')
+			if v > 1<<63 {
+				return 0, ErrQueryOutOfRange
+			}
+		}
+		digits := i
+
+		// The fraction, the digits beyond the precision are dropped
+		var f uint64
+		scale := 1.0
+		if i < len(b) && b[i] == '.' {
+			overflow := false
+			for i++; i < len(b) && '0' <= b[i] && b[i] <= '9'; i++ {
+				digits++
+				if overflow || f > (1<<63-1)/10 {
+					overflow = true
+					continue
+				}
+				f = f*10 + uint64(b[i]-'0')
+				scale *= 10
+			}
+		}
+		if digits == 0 {
+			return 0, ErrQueryMalformed
+		}
+
+		j := i
+		for ; j < len(b) && b[j] != '.' && (b[j] < '0' || b[j] > '9'); j++ {
+		}
+		var unit uint64
+		switch b2s(b[i:j]) {
+		case "ns":
+			unit = uint64(time.Nanosecond)
+		case "us", "\u00b5s", "\u03bcs":
+			unit = uint64(time.Microsecond)
+		case "ms":
+			unit = uint64(time.Millisecond)
+		case "s":
+			unit = uint64(time.Second)
+		case "m":
+			unit = uint64(time.Minute)
+		case "h":
+			unit = uint64(time.Hour)
+		default:
+			return 0, ErrQueryMalformed
+		}
+		b = b[j:]
+
+		if v > 1<<63/unit {
+			return 0, ErrQueryOutOfRange
+		}
+		v *= unit
+		if f > 0 {
+			v += uint64(float64(f) * (float64(unit) / scale))
+			if v > 1<<63 {
+				return 0, ErrQueryOutOfRange
+			}
+		}
+		d += v
+		if d > 1<<63 {
+			return 0, ErrQueryOutOfRange
+		}
+	}
+	if neg {
+		return -time.Duration(d), nil
+	}
+	if d > 1<<63-1 {
+		return 0, ErrQueryOutOfRange
+	}
+	return time.Duration(d), nil
+}
+
+// SetInt sets or adds the name with the decimal value.
+func (q *Query) SetInt(name string, v int64) {
+	p := q.valuePair(name, true)
+	p.value = strconv.AppendInt(p.value[:0], v, 10)
+}
+
+// AddInt adds the name with the decimal value.
+func (q *Query) AddInt(name string, v int64) {
+	p := q.valuePair(name, false)
+	p.value = strconv.AppendInt(p.value[:0], v, 10)
+}
+
+// SetUint sets or adds the name with the decimal value.
+func (q *Query) SetUint(name string, v uint64) {
+	p := q.valuePair(name, true)
+	p.value = strconv.AppendUint(p.value[:0], v, 10)
+}
+
+// AddUint adds the name with the decimal value.
+func (q *Query) AddUint(name string, v uint64) {
+	p := q.valuePair(name, false)
+	p.value = strconv.AppendUint(p.value[:0], v, 10)
+}
+
+// SetFloat sets or adds the name with the shortest value which parses back
+// to v.
+func (q *Query) SetFloat(name string, v float64) {
+	p := q.valuePair(name, true)
+	p.value = strconv.AppendFloat(p.value[:0], v, 'g', -1, 64)
+}
+
+// AddFloat adds the name with the shortest value which parses back to v.
+func (q *Query) AddFloat(name string, v float64) {
+	p := q.valuePair(name, false)
+	p.value = strconv.AppendFloat(p.value[:0], v, 'g', -1, 64)
+}
+
+// SetBool sets or adds the name with true or false.
+func (q *Query) SetBool(name string, v bool) {
+	p := q.valuePair(name, true)
+	p.value = strconv.AppendBool(p.value[:0], v)
+}
+
+// AddBool adds the name with true or false.
+func (q *Query) AddBool(name string, v bool) {
+	p := q.valuePair(name, false)
+	p.value = strconv.AppendBool(p.value[:0], v)
+}
+
+// SetDuration sets or adds the name with the value like 1m30s.
+func (q *Query) SetDuration(name string, v time.Duration) {
+	p := q.valuePair(name, true)
+	p.value = appendDuration(p.value[:0], v)
+}
+
+// AddDuration adds the name with the value like 1m30s.
+func (q *Query) AddDuration(name string, v time.Duration) {
+	p := q.valuePair(name, false)
+	p.value = appendDuration(p.value[:0], v)
+}
+
+// valuePair returns the first pair with the name if set, or else a new pair
+// with the name. The value is left for the caller.
+func (q *Query) valuePair(name string, set bool) *QueryPair {
+	q.modified = true
+	if set {
+		if i := q.first(s2b(name)); i >= 0 {
+			return &q.pairs[i]
+		}
+	}
+	p := q.alloc()
+	p.name = append(p.name[:0], name...)
+	return p
+}
+
+// appendDuration appends the duration like time.Duration.String without
+// building the string.
+func appendDuration(dst []byte, d time.Duration) []byte {
+	var buf [32]byte
+	w := len(buf)
+	u := uint64(d)
+	if d < 0 {
+		u = -u
+	}
+
+	if u < uint64(time.Second) {
+		// The fraction of a second has the smaller unit, like 1.5ms
+		var prec int
+		w--
+		buf[w] = 's'
+		w--
+		switch {
+		case u == 0:
+			return append(dst, "0s"...)
+		case u < uint64(time.Microsecond):
+			prec = 0
+			buf[w] = 'n'
+		case u < uint64(time.Millisecond):
+			prec = 3
+			w--
+			copy(buf[w:], "\u00b5")
+		default:
+			prec = 6
+			buf[w] = 'm'
+		}
+		w, u = appendDurationFrac(buf[:w], u, prec)
+		w = appendDurationInt(buf[:w], u)
+	} else {
+		w--
+		buf[w] = 's'
+		w, u = appendDurationFrac(buf[:w], u, 9)
+		w = appendDurationInt(buf[:w], u%60)
+		u /= 60
+		if u > 0 {
+			w--
+			buf[w] = 'm'
+			w = appendDurationInt(buf[:w], u%60)
+			u /= 60
+			if u > 0 {
+				w--
+				buf[w] = 'h'
+				w = appendDurationInt(buf[:w], u)
+			}
+		}
+	}
+
+	if d < 0 {
+		w--
+		buf[w] = '-'
+	}
+	return append(dst, buf[w:]...)
+}
+
+// appendDurationFrac formats the fraction of v/10**prec without the trailing
+// zeros at the tail of buf, it returns the start of the fraction and v/10**prec.
+func appendDurationFrac(buf []byte, v uint64, prec int) (int, uint64) {
+	w := len(buf)
+	print := false
+	for i := 0; i < prec; i++ {
+		digit := v % 10
+		print = print || digit != 0
+		if print {
+			w--
+			buf[w] = byte(digit) + '0'
+		}
+		v /= 10
+	}
+	if print {
+		w--
+		buf[w] = '.'
+	}
+	return w, v
+}
+
+// appendDurationInt formats v at the tail of buf, it returns the start of v.
+func appendDurationInt(buf []byte, v uint64) int {
+	w := len(buf)
+	if v == 0 {
+		w--
+		buf[w] = '0'
+		return w
+	}
+	for v > 0 {
+		w--
+		buf[w] = byte(v%10) + '0'
+		v /= 10
+	}
+	return w
+}
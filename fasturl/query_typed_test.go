@@ -0,0 +1,164 @@
+package fasturl
+
+import (
+	"math"
+	"math/rand"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryTypedGet(t *testing.T) {
+	var q Query
+	require.Nil(t, q.Decode([]byte("i=-42&u=42&f=1.5&b=true&d=1m30s&e=&x=abc&big=99999999999999999999&neg=-1&i=7")))
+
+	i, err := q.GetInt("i")
+	require.Nil(t, err)
+	require.Equal(t, int64(-42), i)
+
+	u, err := q.GetUint("u")
+	require.Nil(t, err)
+	require.Equal(t, uint64(42), u)
+
+	f, err := q.GetFloat("f")
+	require.Nil(t, err)
+	require.Equal(t, 1.5, f)
+
+	b, err := q.GetBool("b")
+	require.Nil(t, err)
+	require.True(t, b)
+
+	d, err := q.GetDuration("d")
+	require.Nil(t, err)
+	require.Equal(t, 90*time.Second, d)
+
+	for _, tt := range []struct {
+		name string
+		get  func(string) error
+		err  error
+	}{
+		{"missing", func(n string) error { _, err := q.GetInt(n); return err }, ErrQueryMissing},
+		{"e", func(n string) error { _, err := q.GetInt(n); return err }, ErrQueryMalformed},
+		{"x", func(n string) error { _, err := q.GetInt(n); return err }, ErrQueryMalformed},
+		{"big", func(n string) error { _, err := q.GetInt(n); return err }, ErrQueryOutOfRange},
+		{"missing", func(n string) error { _, err := q.GetUint(n); return err }, ErrQueryMissing},
+		{"neg", func(n string) error { _, err := q.GetUint(n); return err }, ErrQueryMalformed},
+		{"missing", func(n string) error { _, err := q.GetFloat(n); return err }, ErrQueryMissing},
+		{"x", func(n string) error { _, err := q.GetFloat(n); return err }, ErrQueryMalformed},
+		{"missing", func(n string) error { _, err := q.GetBool(n); return err }, ErrQueryMissing},
+		{"x", func(n string) error { _, err := q.GetBool(n); return err }, ErrQueryMalformed},
+		{"missing", func(n string) error { _, err := q.GetDuration(n); return err }, ErrQueryMissing},
+		{"x", func(n string) error { _, err := q.GetDuration(n); return err }, ErrQueryMalformed},
+	} {
+		require.Equal(t, tt.err, tt.get(tt.name), tt.name)
+	}
+}
+
+func TestQueryTypedSet(t *testing.T) {
+	var q Query
+	q.AddInt("i", -1)
+	q.SetInt("i", 42)
+	q.AddUint("u", 1)
+	q.AddUint("u", 2)
+	q.SetUint("u", 3)
+	q.SetFloat("f", 0.1)
+	q.AddFloat("f", 1e21)
+	q.SetBool("b", false)
+	q.AddBool("b", true)
+	q.SetDuration("d", 90*time.Second)
+	q.AddDuration("d", time.Millisecond)
+	require.Equal(t, "i=42&u=3&u=2&f=0.1&f=1e%2B21&b=false&b=true&d=1m30s&d=1ms", string(q.Encode(nil)))
+
+	// The buffers of the removed pairs are reused
+	q.Reset()
+	q.SetInt("x", 1)
+	require.Equal(t, "x=1", string(q.Encode(nil)))
+}
+
+func TestQueryTypedLikeStrconv(t *testing.T) {
+	alpha := []byte("0123456789+-.eEinfatyhmsu\xb5")
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 100000; n++ {
+		b := make([]byte, r.Intn(24))
+		for i := range b {
+			b[i] = alpha[r.Intn(len(alpha))]
+		}
+		var q Query
+		q.AddBytes([]byte("v"), b)
+
+		i, err := q.GetInt("v")
+		wantI, wantErr := strconv.ParseInt(string(b), 10, 64)
+		require.Equal(t, wantErr == nil, err == nil, "%q", b)
+		require.Equal(t, wantI*boolInt(err == nil), i, "%q", b)
+
+		u, err := q.GetUint("v")
+		wantU, wantErr := strconv.ParseUint(string(b), 10, 64)
+		require.Equal(t, wantErr == nil, err == nil, "%q", b)
+		require.Equal(t, wantU*uint64(boolInt(err == nil)), u, "%q", b)
+
+		f, err := q.GetFloat("v")
+		wantF, wantErr := strconv.ParseFloat(string(b), 64)
+		require.Equal(t, wantErr == nil, err == nil, "%q", b)
+		if err == nil && !math.IsNaN(wantF) {
+			require.Equal(t, wantF, f, "%q", b)
+		}
+
+		d, err := parseQueryDuration(b)
+		wantD, wantErr := time.ParseDuration(string(b))
+		require.Equal(t, wantErr == nil, err == nil, "%q", b)
+		require.Equal(t, wantD, d, "%q", b)
+	}
+
+	for _, d := range []time.Duration{0, 1, -1, 999, 1000, 1500 * time.Microsecond, time.Second,
+		-90 * time.Second, 26*time.Hour + 3*time.Millisecond, math.MaxInt64, math.MinInt64} {
+		require.Equal(t, d.String(), string(appendDuration([]byte(nil), d)), int64(d))
+	}
+	for n := 0; n < 10000; n++ {
+		d := time.Duration(r.Int63() >> uint(r.Intn(63)))
+		require.Equal(t, d.String(), string(appendDuration(nil, d)), int64(d))
+		require.Equal(t, (-d).String(), string(appendDuration(nil, -d)), int64(d))
+	}
+}
+
+func boolInt(b bool) int64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func TestQueryTypedAllocs(t *testing.T) {
+	var q Query
+	q.EnableIndex()
+	require.Nil(t, q.Decode([]byte("page=2&size=20&score=0.5&k=v")))
+
+	allocs := testing.AllocsPerRun(100, func() {
+		if _, err := q.GetInt("page"); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := q.GetFloat("score"); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := q.GetInt("missing"); err != ErrQueryMissing {
+			t.Fatal(err)
+		}
+		if _, err := q.GetInt("k"); err != ErrQueryMalformed {
+			t.Fatal(err)
+		}
+		if _, err := q.GetFloat("k"); err != ErrQueryMalformed {
+			t.Fatal(err)
+		}
+		if _, err := q.GetBool("k"); err != ErrQueryMalformed {
+			t.Fatal(err)
+		}
+		if _, err := q.GetDuration("k"); err != ErrQueryMalformed {
+			t.Fatal(err)
+		}
+		q.SetInt("page", 3)
+		q.SetUint("size", 50)
+		q.SetDuration("timeout", 90*time.Second)
+	})
+	require.Equal(t, 0.0, allocs)
+}
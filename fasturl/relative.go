@@ -0,0 +1,137 @@
+package fasturl
+
+import "bytes"
+
+// MakeRelative appends the shortest reference which resolves against the
+// base to the target, it is the inverse of ResolveReference.
+func MakeRelative(dst []byte, base, target *FastURL) []byte {
+	if !bytes.Equal(base.protocol, target.protocol) || base.opaque != target.opaque {
+		dst = append(dst, target.protocol...)
+		dst = append(dst, ':')
+		return target.appendReference(dst)
+	}
+
+	if base.opaque {
+		if bytes.Equal(base.pathname, target.pathname) && sameSearch(dst, base, target) {
+			return target.appendHash(dst)
+		}
+		dst = append(dst, target.protocol...)
+		dst = append(dst, ':')
+		return target.appendReference(dst)
+	}
+
+	if base.hasAuthority != target.hasAuthority ||
+		!bytes.Equal(base.user, target.user) ||
+		!bytes.Equal(base.pass, target.pass) ||
+		!bytes.Equal(base.host, target.host) {
+		if !target.hasAuthority {
+			// The path-absolute would take the authority of the base
+			dst = append(dst, target.protocol...)
+			dst = append(dst, ':')
+		}
+		return target.appendReference(dst)
+	}
+
+	special := target.scheme != nil && target.scheme.Special
+	if bytes.Equal(base.pathname, target.pathname) {
+		if sameSearch(dst, base, target) {
+			return target.appendHash(dst)
+		}
+		n := len(dst)
+		dst = target.appendSearch(dst)
+		if len(dst) == n {
+			// The empty path keeps the query of the base, so name the last
+			// segment
+			dst = appendRelativePath(dst, base.pathname, target.pathname, special)
+			if len(dst) == n {
+				dst = append(dst, "./"...)
+			}
+		}
+		return target.appendHash(dst)
+	}
+
+	n := len(dst)
+	dst = appendRelativePath(dst, base.pathname, target.pathname, special)
+	if len(dst) == n {
+		dst = append(dst, "./"...)
+	}
+	// The path-absolute is shorter than climbing up from a deep base
+	if p := target.pathname; !bytes.HasPrefix(p, []byte("//")) {
+		m := len(dst)
+		dst = appendPath(dst, p, special)
+		if m-n > len(dst)-m {
+			dst = append(dst[:n], dst[m:]...)
+		} else {
+			dst = dst[:m]
+		}
+	}
+	dst = target.appendSearch(dst)
+	return target.appendHash(dst)
+}
+
+// appendRelativePath appends the escaped path which resolves against the
+// base path to the target path, both are absolute.
+func appendRelativePath(dst, base, target []byte, special bool) []byte {
+	// The directory of the base
+	if i := bytes.LastIndexByte(base, '/'); i >= 0 {
+		base = base[:i+1]
+	}
+
+	// The longest common directory
+	k := 0
+	for i := 0; i < len(base) && i < len(target) && base[i] == target[i]; i++ {
+		if base[i] == '/' {
+			k = i + 1
+		}
+	}
+
+	n := len(dst)
+	for i := k; i < len(base); i++ {
+		if base[i] == '/' {
+			dst = append(dst, "../"...)
+		}
+	}
+
+	rest := target[k:]
+	if len(rest) == 0 {
+		return dst
+	}
+	if rest[0] == '/' {
+		// The empty segment is taken for an authority or a path-absolute,
+		// and the path-absolute with the empty first segment is taken for
+		// an authority
+		dst = dst[:n]
+		if len(target) > 1 && target[1] == '/' {
+			dst = append(dst, "/."...)
+		}
+		return appendPath(dst, target, special)
+	}
+	if len(dst) == n {
+		// The first segment with ':' is taken for the protocol
+		if i := bytes.IndexByte(rest, ':'); i >= 0 && bytes.IndexByte(rest[:i], '/') < 0 {
+			dst = append(dst, "./"...)
+		}
+	}
+	return appendPath(dst, rest, special)
+}
+
+// sameSearch reports whether the base and the target have the same query,
+// the tail of dst is used as the scratch.
+func sameSearch(dst []byte, base, target *FastURL) bool {
+	n := len(dst)
+	dst = base.appendSearch(dst)
+	m := len(dst)
+	dst = target.appendSearch(dst)
+	return bytes.Equal(dst[n:m], dst[m:])
+}
+
+// appendReference appends the url without protocol.
+func (f *FastURL) appendReference(b []byte) []byte {
+	if f.opaque {
+		b = PercentEncode(b, f.pathname, &opaquePathEncodeSet)
+	} else {
+		b = f.encodeHierarchical(b)
+	}
+	b = f.appendSearch(b)
+	return f.appendHash(b)
+}
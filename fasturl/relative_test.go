@@ -0,0 +1,165 @@
+package fasturl
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMakeRelative(t *testing.T) {
+	for _, tt := range []struct {
+		base   string
+		target string
+		expect string
+	}{
+		{"http://a/b/c/d;p?q", "http://a/b/c/g", "g"},
+		{"http://a/b/c/d;p?q", "http://a/b/c/g/", "g/"},
+		{"http://a/b/c/d;p?q", "http://a/b/c/", "./"},
+		{"http://a/b/c/d;p?q", "http://a/b/g", "../g"},
+		{"http://a/b/c/d;p?q", "http://a/b/", "../"},
+		{"http://a/b/c/d;p?q", "http://a/g", "/g"},
+		{"http://a/b/c/d;p?q", "http://a/", "/"},
+		{"http://a/b/c/d;p?q", "http://a/b/c/d;p?y", "?y"},
+		{"http://a/b/c/d;p?q", "http://a/b/c/d;p?q#s", "#s"},
+		{"http://a/b/c/d;p?q", "http://a/b/c/d;p?q", ""},
+		{"http://a/b/c/d;p?q#s", "http://a/b/c/d;p?q", ""},
+		{"http://a/b/c/d;p?q", "http://a/b/c/d;p", "d;p"},
+		{"http://a/b/c/?q", "http://a/b/c/", "./"},
+		{"http://a/b/c/d;p?q", "http://a/b/c/g?y#s", "g?y#s"},
+		{"http://a/b/c/d;p?q", "http://a/b/c/a:b", "./a:b"},
+		{"http://a/b/c/d;p?q", "http://a/b/c/x/a:b", "x/a:b"},
+		{"http://a/b/c/d;p?q", "http://a/b/c//x", "/b/c//x"},
+		{"http://a/b/c/d/e/f", "http://a/x", "/x"},
+		{"http://a/b/c/d/e/f", "http://a/b/c/x/y", "/b/c/x/y"},
+		{"http://a/b/c/d/e/f", "http://a/b/c/d/x", "../x"},
+		{"http://a/b/c/d;p?q", "http://g/h", "//g/h"},
+		{"http://a/b/c/d;p?q", "http://a:8080/b", "//a:8080/b"},
+		{"http://a/b/c/d;p?q", "http://u:p@a/b", "//u:p@a/b"},
+		{"http://a/b/c/d;p?q", "https://a/b/c/g", "https://a/b/c/g"},
+		{"http://a/b/c/d;p?q", "mailto:u@a?x#y", "mailto:u@a?x#y"},
+		{"mailto:u@a?x", "mailto:u@a?x#y", "#y"},
+		{"mailto:u@a?x", "mailto:v@a?x", "mailto:v@a?x"},
+		{"foo://a/b/c", "foo://a/b/d", "d"},
+		{"foo:/b/c", "foo:/b/d", "d"},
+		{"foo://a/b/c", "foo:/b/d", "foo:/b/d"},
+		{"http://a/z.html/y/z.html", "http://a//y", "/.//y"},
+		{"https://u@a", "https://u@a//", "/.//"},
+	} {
+		var base, target FastURL
+		require.Nil(t, base.Parse([]byte(tt.base)), tt.base)
+		require.Nil(t, target.Parse([]byte(tt.target)), tt.target)
+
+		rel := MakeRelative([]byte("x"), &base, &target)
+		require.Equal(t, "x"+tt.expect, string(rel), tt.target)
+
+		var f FastURL
+		require.Nil(t, f.ParseRelative(&base, rel[1:]), tt.target)
+		require.Equal(t, string(target.Encode(nil)), string(f.Encode(nil)), tt.target)
+	}
+}
+
+func TestMakeRelativeModifiedQuery(t *testing.T) {
+	var base, target FastURL
+	require.Nil(t, base.Parse([]byte("http://a/b?x=1")))
+	require.Nil(t, target.Parse([]byte("http://a/b?x=1")))
+	require.Equal(t, "", string(MakeRelative(nil, &base, &target)))
+
+	target.GetQuery().Set("x", "2")
+	require.Equal(t, "?x=2", string(MakeRelative(nil, &base, &target)))
+
+	target.GetQuery().Del("x")
+	require.Equal(t, "b", string(MakeRelative(nil, &base, &target)))
+}
+
+func TestMakeRelativeSetHash(t *testing.T) {
+	for _, tt := range []struct {
+		base   string
+		target string
+		hash   string
+		expect string
+	}{
+		{"http://a/b?x", "http://a/b?x", "frag", "#frag"},
+		{"http://a/b?x", "http://a/b?x", "a b", "#a%20b"},
+		{"http://a/b?x", "http://a/b?y", "frag", "?y#frag"},
+		{"http://a/b?x", "http://a/c", "a b", "c#a%20b"},
+		{"mailto:u@a?x", "mailto:u@a?x", "frag", "#frag"},
+		{"mailto:u@a?x", "mailto:u@a?x", "a b", "#a%20b"},
+	} {
+		var base, target FastURL
+		require.Nil(t, base.Parse([]byte(tt.base)), tt.base)
+		require.Nil(t, target.Parse([]byte(tt.target)), tt.target)
+		target.SetHash(tt.hash)
+
+		rel := MakeRelative(nil, &base, &target)
+		require.Equal(t, tt.expect, string(rel), tt.hash)
+
+		var f FastURL
+		require.Nil(t, f.ParseRelative(&base, rel), tt.hash)
+		require.Equal(t, string(target.Encode(nil)), string(f.Encode(nil)), tt.hash)
+	}
+}
+
+func TestMakeRelativeSetPathname(t *testing.T) {
+	for _, tt := range []struct {
+		base     string
+		pathname string
+		expect   string
+	}{
+		{"http://a/b/c", "/b/c d?e#f", "c%20d%3Fe%23f"},
+		{"http://a/b/c/d/e", "/x y", "/x%20y"},
+		{"http://a/b/c", "/b/a:b c", "./a:b%20c"},
+		{"http://a/b/c", "/b/a\\b", "a%5Cb"},
+		{"http://a/b/c", "//x y", "/.//x%20y"},
+		{"foo://a/b/c", "/b/a\\b", "a\\b"},
+	} {
+		var base, target FastURL
+		require.Nil(t, base.Parse([]byte(tt.base)), tt.base)
+		require.Nil(t, target.Parse([]byte(tt.base)), tt.base)
+		target.SetPathname(tt.pathname)
+
+		rel := MakeRelative(nil, &base, &target)
+		require.Equal(t, tt.expect, string(rel), tt.pathname)
+
+		var f FastURL
+		require.Nil(t, f.ParseRelative(&base, rel), tt.pathname)
+		require.Equal(t, string(target.Encode(nil)), string(f.Encode(nil)), tt.pathname)
+	}
+}
+
+func TestMakeRelativeRoundTrip(t *testing.T) {
+	pick := func(r *rand.Rand, s ...string) string {
+		return s[r.Intn(len(s))]
+	}
+	randURL := func(r *rand.Rand) string {
+		var b strings.Builder
+		b.WriteString(pick(r, "http:", "https:", "foo:"))
+		if r.Intn(4) > 0 {
+			b.WriteString("//")
+			b.WriteString(pick(r, "", "u@", "u:p@"))
+			b.WriteString(pick(r, "a", "b", "a:8080"))
+		}
+		for n := r.Intn(4); n > 0; n-- {
+			b.WriteString(pick(r, "/", "/", "x:"))
+			b.WriteString(pick(r, "", "", "y", "z.html", "a:b"))
+		}
+		b.WriteString(pick(r, "", "", "?", "?q"))
+		b.WriteString(pick(r, "", "", "#", "#s"))
+		return b.String()
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 100000; n++ {
+		var base, target FastURL
+		bs, ts := randURL(r), randURL(r)
+		if base.Parse([]byte(bs)) != nil || target.Parse([]byte(ts)) != nil {
+			continue
+		}
+
+		rel := MakeRelative(nil, &base, &target)
+		var f FastURL
+		require.Nil(t, f.ParseRelative(&base, rel), "%s %s %s", bs, ts, rel)
+		require.Equal(t, string(target.Encode(nil)), string(f.Encode(nil)), "%s %s %s", bs, ts, rel)
+	}
+}
@@ -0,0 +1,140 @@
+package fasturl
+
+import "bytes"
+
+// ParseRelative parses the input, which may be a relative reference like
+// ../img/a.png, and resolves it against the base.
+//
+// More detail see https://url.spec.whatwg.org/#concept-basic-url-parser
+func ParseRelative(f, base *FastURL, input []byte) error {
+	o := ParseOptions{
+		Reference: true,
+		base:      base.scheme,
+	}
+
+	// The special protocol of the base without "//" is a relative reference,
+	// like http:g against http://a/b/c
+	if base.scheme != nil && base.scheme.Special {
+		if pi := bytes.IndexByte(input, ':'); pi == len(base.protocol) &&
+			bytes.EqualFold(input[:pi], base.protocol) {
+			rest := input[pi+1:]
+			if len(rest) < 2 || !isSlash(rest[0], true) || !isSlash(rest[1], true) {
+				input = rest
+			}
+		}
+	}
+
+	if err := parse(f, input, o); err != nil {
+		return err
+	}
+	return resolve(f, base)
+}
+
+// ResolveReference resolves the ref, which is parsed with
+// ParseOptions.Reference, against the base to dst. dst may be the ref but
+// must not be the base.
+//
+// More detail see https://tools.ietf.org/html/rfc3986#section-5.2
+func ResolveReference(dst, base, ref *FastURL) error {
+	if dst != ref {
+		dst.copyFrom(ref)
+	}
+	return resolve(dst, base)
+}
+
+// resolve resolves the reference f against the base in place.
+func resolve(f, base *FastURL) error {
+	if len(f.protocol) > 0 {
+		if !f.opaque {
+			f.pathname = removeDotSegments(f.pathname)
+		}
+		return nil
+	}
+
+	if base.opaque {
+		// Only the fragment can be resolved against an opaque path
+		if f.hasAuthority || len(f.pathname) > 0 || len(f.rawquery) > 0 {
+			return ErrFastURLOpaqueBase
+		}
+		f.protocol = append(f.protocol[:0], base.protocol...)
+		f.scheme = base.scheme
+		f.opaque = true
+		f.pathname = append(f.pathname[:0], base.pathname...)
+		f.normalizedPathname = append(f.normalizedPathname[:0], base.normalizedPathname...)
+		f.copyQueryFrom(base)
+		return nil
+	}
+
+	f.protocol = append(f.protocol[:0], base.protocol...)
+	f.scheme = base.scheme
+
+	if f.hasAuthority {
+		if f.scheme != nil && string(f.port) == f.scheme.DefaultPort {
+			f.port = f.port[:0]
+			buildHost(f)
+		}
+		f.pathname = removeDotSegments(f.pathname)
+		f.normalizedPathname = NormalizePathname(f.normalizedPathname[:0], f.pathname)
+		return nil
+	}
+
+	f.hasAuthority = base.hasAuthority
+	f.auth = append(f.auth[:0], base.auth...)
+	f.user = append(f.user[:0], base.user...)
+	f.pass = append(f.pass[:0], base.pass...)
+	f.host = append(f.host[:0], base.host...)
+	f.hostname = append(f.hostname[:0], base.hostname...)
+	f.port = append(f.port[:0], base.port...)
+	f.hostType = base.hostType
+
+	switch {
+	case len(f.pathname) == 0:
+		f.pathname = append(f.pathname, base.pathname...)
+		if len(f.rawquery) == 0 {
+			f.copyQueryFrom(base)
+		}
+	case f.pathname[0] == '/':
+		f.pathname = removeDotSegments(f.pathname)
+	default:
+		// Merge the path with the base, normalizedPathname is the scratch
+		b := f.normalizedPathname[:0]
+		if i := bytes.LastIndexByte(base.pathname, '/'); i >= 0 {
+			b = append(b, base.pathname[:i+1]...)
+		} else {
+			b = append(b, '/')
+		}
+		b = append(b, f.pathname...)
+		f.pathname, f.normalizedPathname = removeDotSegments(b), f.pathname
+	}
+
+	f.normalizedPathname = NormalizePathname(f.normalizedPathname[:0], f.pathname)
+	return nil
+}
+
+// copyQueryFrom copies the query of src to f.
+func (f *FastURL) copyQueryFrom(src *FastURL) {
+	f.rawquery = append(f.rawquery[:0], src.rawquery...)
+	f.parsequery = src.parsequery
+	f.query.copyFrom(&src.query)
+}
+
+// copyFrom copies src to f, reusing the buffers of f.
+func (f *FastURL) copyFrom(src *FastURL) {
+	f.protocol = append(f.protocol[:0], src.protocol...)
+	f.scheme = src.scheme
+	f.auth = append(f.auth[:0], src.auth...)
+	f.user = append(f.user[:0], src.user...)
+	f.pass = append(f.pass[:0], src.pass...)
+	f.host = append(f.host[:0], src.host...)
+	f.hostname = append(f.hostname[:0], src.hostname...)
+	f.unbracketedHostname = f.unbracketedHostname[:0]
+	f.port = append(f.port[:0], src.port...)
+	f.hostType = src.hostType
+	f.pathname = append(f.pathname[:0], src.pathname...)
+	f.opaque = src.opaque
+	f.hasAuthority = src.hasAuthority
+	f.normalizedPathname = append(f.normalizedPathname[:0], src.normalizedPathname...)
+	f.copyQueryFrom(src)
+	f.hash = append(f.hash[:0], src.hash...)
+	f.validationErrors = append(f.validationErrors[:0], src.validationErrors...)
+}
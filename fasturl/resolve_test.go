@@ -0,0 +1,153 @@
+package fasturl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRelative(t *testing.T) {
+	var base FastURL
+	require.Nil(t, base.Parse([]byte("http://a/b/c/d;p?q")))
+
+	// More detail see https://tools.ietf.org/html/rfc3986#section-5.4
+	for _, tt := range []struct {
+		input  string
+		expect string
+	}{
+		{"g:h", "g:h"},
+		{"g", "http://a/b/c/g"},
+		{"./g", "http://a/b/c/g"},
+		{"g/", "http://a/b/c/g/"},
+		{"/g", "http://a/g"},
+		{"//g", "http://g/"},
+		{"?y", "http://a/b/c/d;p?y"},
+		{"g?y", "http://a/b/c/g?y"},
+		{"#s", "http://a/b/c/d;p?q#s"},
+		{"g#s", "http://a/b/c/g#s"},
+		{"g?y#s", "http://a/b/c/g?y#s"},
+		{";x", "http://a/b/c/;x"},
+		{"g;x", "http://a/b/c/g;x"},
+		{"g;x?y#s", "http://a/b/c/g;x?y#s"},
+		{"", "http://a/b/c/d;p?q"},
+		{".", "http://a/b/c/"},
+		{"./", "http://a/b/c/"},
+		{"..", "http://a/b/"},
+		{"../", "http://a/b/"},
+		{"../g", "http://a/b/g"},
+		{"../..", "http://a/"},
+		{"../../", "http://a/"},
+		{"../../g", "http://a/g"},
+		{"../../../g", "http://a/g"},
+		{"../../../../g", "http://a/g"},
+		{"/./g", "http://a/g"},
+		{"/../g", "http://a/g"},
+		{"g.", "http://a/b/c/g."},
+		{".g", "http://a/b/c/.g"},
+		{"g..", "http://a/b/c/g.."},
+		{"..g", "http://a/b/c/..g"},
+		{"./../g", "http://a/b/g"},
+		{"./g/.", "http://a/b/c/g/"},
+		{"g/./h", "http://a/b/c/g/h"},
+		{"g/../h", "http://a/b/c/h"},
+		{"g;x=1/./y", "http://a/b/c/g;x=1/y"},
+		{"g;x=1/../y", "http://a/b/c/y"},
+		{"g?y/./x", "http://a/b/c/g?y/./x"},
+		{"g?y/../x", "http://a/b/c/g?y/../x"},
+		{"g#s/./x", "http://a/b/c/g#s/./x"},
+		{"g#s/../x", "http://a/b/c/g#s/../x"},
+		{"http:g", "http://a/b/c/g"},
+		{"HTTP:/g", "http://a/g"},
+		{"http://x/../y", "http://x/y"},
+		{"https://x:443/./y", "https://x/y"},
+		{"..\\g", "http://a/b/g"},
+		{"\\\\g\\h", "http://g/h"},
+		{"//g:80/h", "http://g/h"},
+		{"//u:p@g:8080", "http://u:p@g:8080/"},
+		{"../img/a%2Fb.png", "http://a/b/img/a%2Fb.png"},
+	} {
+		var f FastURL
+		err := f.ParseRelative(&base, []byte(tt.input))
+		require.Nil(t, err, tt.input)
+		require.Equal(t, tt.expect, string(f.Encode(nil)), tt.input)
+	}
+}
+
+func TestParseRelativeNonSpecial(t *testing.T) {
+	var base FastURL
+	require.Nil(t, base.Parse([]byte("foo://a/b/c")))
+
+	for _, tt := range []struct {
+		input  string
+		expect string
+	}{
+		{"d", "foo://a/b/d"},
+		{"..\\d", "foo://a/b/..\\d"},
+		{"foo:d", "foo:d"},
+		{"//x/y", "foo://x/y"},
+	} {
+		var f FastURL
+		err := f.ParseRelative(&base, []byte(tt.input))
+		require.Nil(t, err, tt.input)
+		require.Equal(t, tt.expect, string(f.Encode(nil)), tt.input)
+	}
+}
+
+func TestParseRelativeOpaqueBase(t *testing.T) {
+	var base FastURL
+	require.Nil(t, base.Parse([]byte("mailto:user@example.com?subject=hi")))
+
+	var f FastURL
+	require.Nil(t, f.ParseRelative(&base, []byte("#top")))
+	require.True(t, f.IsOpaque())
+	require.Equal(t, "mailto:user@example.com?subject=hi#top", string(f.Encode(nil)))
+
+	for _, input := range []string{"a", "/a", "//a", "?a"} {
+		f.Reset()
+		require.Equal(t, ErrFastURLOpaqueBase, f.ParseRelative(&base, []byte(input)), input)
+	}
+}
+
+func TestResolveReference(t *testing.T) {
+	var base, ref, dst FastURL
+	require.Nil(t, base.Parse([]byte("https://user@example.com:8443/static/css/a.css?v=1")))
+	require.Nil(t, ref.ParseWithOptions([]byte("../img/a.png#x"), ParseOptions{Reference: true}))
+	require.Equal(t, "../img/a.png", string(ref.GetPathname()))
+	require.Equal(t, "", string(ref.GetHost()))
+
+	require.Nil(t, ResolveReference(&dst, &base, &ref))
+	require.Equal(t, "https://user:@example.com:8443/static/img/a.png#x", string(dst.Encode(nil)))
+	require.Equal(t, "user", string(dst.GetUser()))
+	require.Equal(t, "/static/img/a.png", string(dst.GetNormalizedPathname()))
+	// The ref is untouched
+	require.Equal(t, "../img/a.png", string(ref.GetPathname()))
+
+	// The query of the base is kept by the empty path
+	ref.Reset()
+	require.Nil(t, ref.ParseWithOptions([]byte(""), ParseOptions{Reference: true}))
+	base.GetQuery().Set("v", "2")
+	require.Nil(t, ResolveReference(&ref, &base, &ref))
+	require.Equal(t, "https://user:@example.com:8443/static/css/a.css?v=2", string(ref.Encode(nil)))
+}
+
+func TestRemoveDotSegments(t *testing.T) {
+	for _, tt := range []struct {
+		input  string
+		expect string
+	}{
+		{"", ""},
+		{"/", "/"},
+		{"/a/b/c/./../../g", "/a/g"},
+		{"mid/content=5/../6", "mid/6"},
+		{"/a/.", "/a/"},
+		{"/a/..", "/"},
+		{"/..", "/"},
+		{"..", ""},
+		{"../a", "a"},
+		{"./a/../b", "/b"},
+		{"/a//../b", "/a/b"},
+	} {
+		b := []byte(tt.input)
+		require.Equal(t, tt.expect, string(removeDotSegments(b)), tt.input)
+	}
+}
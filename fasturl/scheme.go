@@ -0,0 +1,103 @@
+package fasturl
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// Scheme describes how the urls of a protocol are parsed and encoded.
+type Scheme struct {
+	// Name is the lowercase name of the scheme.
+	Name string
+	// DefaultPort is the port which is elided from the url, empty if none.
+	DefaultPort string
+	// Special follows the rules of the WHATWG special schemes: backslashes
+	// are treated as slashes and the url always has an authority.
+	Special bool
+	// RequireHost rejects the urls without host.
+	RequireHost bool
+}
+
+var (
+	schemesMu sync.Mutex
+	schemes   atomic.Value // map[string]*Scheme
+)
+
+func init() {
+	m := make(map[string]*Scheme)
+	// More detail see https://url.spec.whatwg.org/#special-scheme
+	for _, s := range []Scheme{
+		{Name: "ftp", DefaultPort: "21", Special: true, RequireHost: true},
+		{Name: "file", Special: true},
+		{Name: "http", DefaultPort: "80", Special: true, RequireHost: true},
+		{Name: "https", DefaultPort: "443", Special: true, RequireHost: true},
+		{Name: "ws", DefaultPort: "80", Special: true, RequireHost: true},
+		{Name: "wss", DefaultPort: "443", Special: true, RequireHost: true},
+	} {
+		s := s
+		m[s.Name] = &s
+	}
+	schemes.Store(m)
+}
+
+// RegisterScheme registers the scheme, replacing the one with the same name.
+// It is safe to call concurrently with the parsing.
+func RegisterScheme(s Scheme) {
+	b := []byte(s.Name)
+	toLowercsaeASCII(b)
+	s.Name = string(b)
+
+	schemesMu.Lock()
+	defer schemesMu.Unlock()
+
+	old := schemes.Load().(map[string]*Scheme)
+	m := make(map[string]*Scheme, len(old)+1)
+	for k, v := range old {
+		m[k] = v
+	}
+	m[s.Name] = &s
+	schemes.Store(m)
+}
+
+// LookupScheme looks up the scheme by the lowercase name, the bool is false
+// if the scheme is not registered. The scheme is a copy, it is changed by
+// RegisterScheme only.
+func LookupScheme(name []byte) (Scheme, bool) {
+	if s := lookupScheme(name); s != nil {
+		return *s, true
+	}
+	return Scheme{}, false
+}
+
+// lookupScheme looks up the registered scheme, which is shared and must not
+// be modified.
+func lookupScheme(name []byte) *Scheme {
+	return schemes.Load().(map[string]*Scheme)[string(name)]
+}
+
+// isValidScheme reports whether b is a valid scheme:
+//
+//	scheme = ALPHA *( ALPHA / DIGIT / "+" / "-" / "." )
+func isValidScheme(b []byte) bool {
+	if len(b) == 0 {
+		return false
+	}
+	for i := 0; i < len(b); i++ {
+		if !isSchemeChar(b[i], i == 0) {
+			return false
+		}
+	}
+	return true
+}
+
+// isSchemeChar reports whether c may appear in a scheme, the first character
+// must be a letter.
+func isSchemeChar(c byte, first bool) bool {
+	switch {
+	case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z':
+		return true
+	case !first && ('0' <= c && c <= '9' || c == '+' || c == '-' || c == '.'):
+		return true
+	}
+	return false
+}
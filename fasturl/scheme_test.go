@@ -0,0 +1,116 @@
+package fasturl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheme(t *testing.T) {
+	for _, tt := range []struct {
+		input    string
+		haserr   bool
+		protocol string
+		host     string
+		port     string
+		pathname string
+		href     string
+	}{
+		{input: "http://x:80/", protocol: "http", host: "x", pathname: "/", href: "http://x/"},
+		{input: "https://x:443/a", protocol: "https", host: "x", pathname: "/a", href: "https://x/a"},
+		{input: "https://x:80/a", protocol: "https", host: "x:80", port: "80", pathname: "/a", href: "https://x:80/a"},
+		{input: "ws://x:80/", protocol: "ws", host: "x", pathname: "/", href: "ws://x/"},
+		{input: "ftp://x:21/", protocol: "ftp", host: "x", pathname: "/", href: "ftp://x/"},
+		{input: "http://x:/", protocol: "http", host: "x", pathname: "/", href: "http://x/"},
+		{input: "HTTP:\\\\x\\y", protocol: "http", host: "x", pathname: "/y", href: "http://x/y"},
+		{input: "http:x/y", protocol: "http", host: "x", pathname: "/y", href: "http://x/y"},
+		{input: "http:///x/y", protocol: "http", host: "x", pathname: "/y", href: "http://x/y"},
+		{input: "foo://x:80/a\\b", protocol: "foo", host: "x:80", port: "80", pathname: "/a\\b", href: "foo://x:80/a\\b"},
+		{input: "file:///etc/hosts", protocol: "file", pathname: "/etc/hosts", href: "file:///etc/hosts"},
+		{input: "file://localhost/etc/hosts", protocol: "file", pathname: "/etc/hosts", href: "file:///etc/hosts"},
+		{input: "file:/etc/hosts", protocol: "file", pathname: "/etc/hosts", href: "file:///etc/hosts"},
+		{input: "file://server/share", protocol: "file", host: "server", pathname: "/share", href: "file://server/share"},
+		{input: "/a:b", pathname: "/a:b", href: "/a:b"},
+		{input: "http://", haserr: true},
+		{input: "http://user@:80/", haserr: true},
+		{input: "wss:///", haserr: true},
+		{input: "file://user@host/", haserr: true},
+		{input: "file://host:80/", haserr: true},
+	} {
+		var f FastURL
+		err := f.Parse([]byte(tt.input))
+		if tt.haserr {
+			require.NotNil(t, err, tt.input)
+			continue
+		}
+		require.Nil(t, err, tt.input)
+		require.Equal(t, tt.protocol, string(f.GetProtocol()), tt.input)
+		require.Equal(t, tt.host, string(f.GetHost()), tt.input)
+		require.Equal(t, tt.port, string(f.GetPort()), tt.input)
+		require.Equal(t, tt.pathname, string(f.GetPathname()), tt.input)
+		require.Equal(t, tt.href, string(f.Encode(nil)), tt.input)
+	}
+}
+
+func TestSchemeDefaultPort(t *testing.T) {
+	var f FastURL
+	require.Nil(t, f.Parse([]byte("https://example.com/")))
+	require.Equal(t, "", string(f.GetPort()))
+	require.Equal(t, "443", string(f.GetPortOrDefault()))
+
+	f.SetPort("443")
+	require.Equal(t, "https://example.com/", string(f.Encode(nil)))
+	f.SetProtocol("HTTP")
+	require.Equal(t, "http://example.com:443/", string(f.Encode(nil)))
+
+	f.Reset()
+	require.Nil(t, f.Parse([]byte("foo://example.com/")))
+	_, ok := f.GetScheme()
+	require.False(t, ok)
+	require.Equal(t, "", string(f.GetPortOrDefault()))
+}
+
+// keepSchemes returns the func which restores the registered schemes.
+func keepSchemes() func() {
+	old := schemes.Load()
+	return func() {
+		schemes.Store(old)
+	}
+}
+
+func TestRegisterScheme(t *testing.T) {
+	defer keepSchemes()()
+
+	RegisterScheme(Scheme{Name: "Redis", DefaultPort: "6379", RequireHost: true})
+	s, ok := LookupScheme([]byte("redis"))
+	require.True(t, ok)
+	require.Equal(t, "6379", s.DefaultPort)
+
+	var f FastURL
+	require.Nil(t, f.Parse([]byte("redis://:secret@cache:6379/0")))
+	require.Equal(t, "cache", string(f.GetHost()))
+	require.Equal(t, "6379", string(f.GetPortOrDefault()))
+	require.Equal(t, "redis://:secret@cache/0", string(f.Encode(nil)))
+
+	f.Reset()
+	require.NotNil(t, f.Parse([]byte("redis:///0")))
+}
+
+func TestSchemeCopy(t *testing.T) {
+	var f FastURL
+	require.Nil(t, f.Parse([]byte("https://example.com/")))
+	s, ok := f.GetScheme()
+	require.True(t, ok)
+	s.DefaultPort = "8443"
+	s.Special = false
+
+	s, ok = LookupScheme([]byte("https"))
+	require.True(t, ok)
+	s.DefaultPort = "8443"
+
+	f.Reset()
+	require.Nil(t, f.Parse([]byte("https:\\\\example.com:443\\a")))
+	require.Equal(t, "https://example.com/a", string(f.Encode(nil)))
+	s, _ = f.GetScheme()
+	require.Equal(t, "443", s.DefaultPort)
+}
@@ -0,0 +1,235 @@
+package fasturl
+
+import "bytes"
+
+// ParseStrict parses the url to FastURL, rejecting the url which does not
+// conform to RFC 3986.
+func ParseStrict(f *FastURL, url []byte) error {
+	return parse(f, url, ParseOptions{
+		Strict: true,
+	})
+}
+
+// isUnreserved reports whether c is an unreserved character:
+//
+//	unreserved = ALPHA / DIGIT / "-" / "." / "_" / "~"
+func isUnreserved(c byte) bool {
+	switch {
+	case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9':
+		return true
+	}
+	return c == '-' || c == '.' || c == '_' || c == '~'
+}
+
+// isSubDelim reports whether c is a sub-delims character:
+//
+//	sub-delims = "!" / "$" / "&" / "'" / "(" / ")" / "*" / "+" / "," / ";" / "="
+func isSubDelim(c byte) bool {
+	switch c {
+	case '!', '$', '&', '\'', '(', ')', '*', '+', ',', ';', '=':
+		return true
+	}
+	return false
+}
+
+// isPChar reports whether c is a pchar other than pct-encoded:
+//
+//	pchar = unreserved / pct-encoded / sub-delims / ":" / "@"
+func isPChar(c byte) bool {
+	return isUnreserved(c) || isSubDelim(c) || c == ':' || c == '@'
+}
+
+// checkChars checks that every byte of src, which is at offset base of the
+// input, is pct-encoded or allowed by valid.
+func checkChars(component Component, src []byte, base int, valid func(c byte) bool) error {
+	for i := 0; i < len(src); i++ {
+		c := src[i]
+		if c == '%' {
+			if i+2 >= len(src) || !ishex(src[i+1]) || !ishex(src[i+2]) {
+				return newParseError(component, ErrorKindInvalidPercentEncoding, src, base, i, 3)
+			}
+			i += 2
+			continue
+		}
+		if !valid(c) {
+			return newParseError(component, ErrorKindInvalidCharacter, src, base, i, 1)
+		}
+	}
+	return nil
+}
+
+func isUserinfoChar(c byte) bool {
+	return isUnreserved(c) || isSubDelim(c) || c == ':'
+}
+
+func isRegNameChar(c byte) bool {
+	return isUnreserved(c) || isSubDelim(c)
+}
+
+func isPathChar(c byte) bool {
+	return isPChar(c) || c == '/'
+}
+
+func isQueryChar(c byte) bool {
+	return isPChar(c) || c == '/' || c == '?'
+}
+
+func isIPvFutureChar(c byte) bool {
+	return isUnreserved(c) || isSubDelim(c) || c == ':'
+}
+
+// isIPvFuture reports whether src, without the brackets, is an IPvFuture:
+//
+//	IPvFuture = "v" 1*HEXDIG "." 1*( unreserved / sub-delims / ":" )
+func isIPvFuture(src []byte) bool {
+	if len(src) == 0 || (src[0] != 'v' && src[0] != 'V') {
+		return false
+	}
+	dot := bytes.IndexByte(src, '.')
+	if dot < 2 || dot == len(src)-1 {
+		return false
+	}
+	for i := 1; i < dot; i++ {
+		if !ishex(src[i]) {
+			return false
+		}
+	}
+	for i := dot + 1; i < len(src); i++ {
+		if !isIPvFutureChar(src[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// checkRFC3986 checks the url against the URI-reference of RFC 3986 or,
+// without protocol, against authority path-abempty [ "?" query ] [ "#" fragment ].
+//
+// More detail see https://tools.ietf.org/html/rfc3986#appendix-A
+func checkRFC3986(url []byte, withoutProtocol bool) error {
+	end := len(url)
+	if i := bytes.IndexByte(url, '#'); i >= 0 {
+		if err := checkChars(ComponentFragment, url[i+1:], i+1, isQueryChar); err != nil {
+			return err
+		}
+		end = i
+	}
+	if i := bytes.IndexByte(url[:end], '?'); i >= 0 {
+		if err := checkChars(ComponentQuery, url[i+1:end], i+1, isQueryChar); err != nil {
+			return err
+		}
+		end = i
+	}
+	url = url[:end]
+
+	pos := 0
+	authority := withoutProtocol
+	if !withoutProtocol {
+		if i := bytes.IndexAny(url, ":/"); i >= 0 && url[i] == ':' {
+			// A first segment with ':' is only allowed as the scheme
+			if err := checkScheme(url[:i]); err != nil {
+				return err
+			}
+			pos = i + 1
+		}
+		if len(url[pos:]) >= 2 && string(url[pos:pos+2]) == "//" {
+			pos += 2
+			authority = true
+		}
+	}
+
+	if authority {
+		aend := len(url)
+		if i := bytes.IndexByte(url[pos:], '/'); i >= 0 {
+			aend = pos + i
+		}
+		if err := checkAuthority(url[pos:aend], pos); err != nil {
+			return err
+		}
+		pos = aend
+	}
+
+	return checkChars(ComponentPath, url[pos:], pos, isPathChar)
+}
+
+func checkScheme(scheme []byte) error {
+	if len(scheme) == 0 {
+		return newParseError(ComponentScheme, ErrorKindInvalidCharacter, scheme, 0, 0, 1)
+	}
+	for i := 0; i < len(scheme); i++ {
+		if !isSchemeChar(scheme[i], i == 0) {
+			return newParseError(ComponentScheme, ErrorKindInvalidCharacter, scheme, 0, i, 1)
+		}
+	}
+	return nil
+}
+
+// checkAuthority checks the authority at offset base of the input:
+//
+//	authority = [ userinfo "@" ] host [ ":" port ]
+func checkAuthority(src []byte, base int) error {
+	if i := bytes.LastIndexByte(src, '@'); i >= 0 {
+		if err := checkChars(ComponentUserinfo, src[:i], base, isUserinfoChar); err != nil {
+			return err
+		}
+		src, base = src[i+1:], base+i+1
+	}
+
+	host := src
+	var port []byte
+	portBase := -1
+	if len(src) > 0 && src[0] == '[' {
+		i := bytes.IndexByte(src, ']')
+		if i < 0 {
+			return newParseError(ComponentHost, ErrorKindInvalidIPv6, src, base, 0, len(src))
+		}
+		if rest := src[i+1:]; len(rest) > 0 {
+			if rest[0] != ':' {
+				return newParseError(ComponentHost, ErrorKindInvalidCharacter, src, base, i+1, 1)
+			}
+			port, portBase = rest[1:], base+i+2
+		}
+		host = src[:i+1]
+		if err := checkIPLiteral(host, base); err != nil {
+			return err
+		}
+	} else {
+		if i := bytes.IndexByte(src, ':'); i >= 0 {
+			host, port, portBase = src[:i], src[i+1:], base+i+1
+		}
+		if err := checkChars(ComponentHost, host, base, isRegNameChar); err != nil {
+			return err
+		}
+	}
+
+	if portBase >= 0 {
+		return checkPort(port, portBase)
+	}
+	return nil
+}
+
+// checkIPLiteral checks the bracketed literal at offset base of the input:
+//
+//	IP-literal = "[" ( IPv6address / IPvFuture ) "]"
+//
+// The IPv6address may carry a RFC 6874 ZoneID.
+func checkIPLiteral(src []byte, base int) error {
+	literal := src[1 : len(src)-1]
+	if len(literal) > 0 && (literal[0] == 'v' || literal[0] == 'V') {
+		if !isIPvFuture(literal) {
+			return newParseError(ComponentHost, ErrorKindInvalidIPv6, src, base, 0, len(src))
+		}
+		return nil
+	}
+
+	if zi := bytes.Index(literal, []byte("%25")); zi >= 0 {
+		if !isZoneID(literal[zi+3:]) {
+			return newParseError(ComponentHost, ErrorKindInvalidIPv6, src, base, 0, len(src))
+		}
+		literal = literal[:zi]
+	}
+	if _, ok := parseIPv6(literal); !ok {
+		return newParseError(ComponentHost, ErrorKindInvalidIPv6, src, base, 0, len(src))
+	}
+	return nil
+}
@@ -0,0 +1,223 @@
+package fasturl
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// StripRules strips the query pairs, like the tracking parameters utm_source
+// and fbclid, by their names.
+//
+// A rule is one of:
+//
+//	fbclid   the exact name
+//	utm_*    the prefix, a '*' at the end only
+//	pf_rd_?  the glob, '*' matches any bytes and '?' matches one byte
+//
+// The rules added for a host apply to the host and its subdomains. The names
+// are case sensitive. StripRules must not be modified while it is used.
+type StripRules struct {
+	global stripRuleSet
+	hosts  map[string]*stripRuleSet
+}
+
+type stripRuleSet struct {
+	exact    map[string]struct{}
+	prefixes []string
+	globs    []string
+}
+
+func (s *stripRuleSet) add(rule string) {
+	switch i := strings.IndexAny(rule, "*?"); {
+	case i < 0:
+		if s.exact == nil {
+			s.exact = make(map[string]struct{})
+		}
+		s.exact[rule] = struct{}{}
+	case i == len(rule)-1 && rule[i] == '*':
+		s.prefixes = append(s.prefixes, rule[:i])
+	default:
+		s.globs = append(s.globs, rule)
+	}
+}
+
+func (s *stripRuleSet) match(name []byte) bool {
+	if _, ok := s.exact[string(name)]; ok {
+		return true
+	}
+	n := b2s(name)
+	for _, p := range s.prefixes {
+		if strings.HasPrefix(n, p) {
+			return true
+		}
+	}
+	for _, g := range s.globs {
+		if matchGlob(g, n) {
+			return true
+		}
+	}
+	return false
+}
+
+// Add adds the rules for all hosts.
+func (r *StripRules) Add(rules ...string) {
+	for _, rule := range rules {
+		r.global.add(rule)
+	}
+}
+
+// AddForHost adds the rules for the host and its subdomains.
+func (r *StripRules) AddForHost(host string, rules ...string) {
+	host = strings.ToLower(host)
+	if r.hosts == nil {
+		r.hosts = make(map[string]*stripRuleSet)
+	}
+	s := r.hosts[host]
+	if s == nil {
+		s = &stripRuleSet{}
+		r.hosts[host] = s
+	}
+	for _, rule := range rules {
+		s.add(rule)
+	}
+}
+
+// Match reports whether the query pair with the name is stripped from the
+// url with the lowercase hostname.
+func (r *StripRules) Match(hostname, name []byte) bool {
+	if r.global.match(name) {
+		return true
+	}
+	if len(r.hosts) == 0 {
+		return false
+	}
+
+	// Match the host and then its parent domains
+	for h := hostname; len(h) > 0; {
+		if s, ok := r.hosts[string(h)]; ok && s.match(name) {
+			return true
+		}
+		i := bytes.IndexByte(h, '.')
+		if i < 0 {
+			break
+		}
+		h = h[i+1:]
+	}
+	return false
+}
+
+// StripQuery strips the query pairs of the url with the hostname and
+// returns the number of the stripped pairs.
+func (r *StripRules) StripQuery(q *Query, hostname []byte) int {
+	n := 0
+	for i := 0; i < len(q.pairs); i++ {
+		if r.Match(hostname, q.pairs[i].name) {
+			q.removePair(i)
+			i--
+			n++
+		}
+	}
+	return n
+}
+
+// Strip strips the query pairs of the url and returns the number of the
+// stripped pairs.
+func (r *StripRules) Strip(f *FastURL) int {
+	return r.StripQuery(f.GetQuery(), f.hostname)
+}
+
+// Load loads the rules from the text, which has one rule per line. The rules
+// after a [host] line are added for the host, '#' starts a comment.
+//
+//	# tracking parameters
+//	utm_*
+//	fbclid
+//
+//	[amazon.com]
+//	pf_rd_*
+//	ref
+func (r *StripRules) Load(rd io.Reader) error {
+	host := ""
+	sc := bufio.NewScanner(rd)
+	for line := 1; sc.Scan(); line++ {
+		rule := sc.Text()
+		if i := strings.IndexByte(rule, '#'); i >= 0 {
+			rule = rule[:i]
+		}
+		rule = strings.TrimSpace(rule)
+
+		switch {
+		case rule == "":
+		case rule[0] == '[':
+			if rule[len(rule)-1] != ']' || len(rule) == 2 {
+				return fmt.Errorf("fasturl: invalid host at line %d: %q", line, rule)
+			}
+			host = rule[1 : len(rule)-1]
+		case host == "":
+			r.Add(rule)
+		default:
+			r.AddForHost(host, rule)
+		}
+	}
+	return sc.Err()
+}
+
+// stripRulesJSON is the JSON form of StripRules.
+type stripRulesJSON struct {
+	Rules []string            `json:"rules"`
+	Hosts map[string][]string `json:"hosts"`
+}
+
+// LoadRulesJSON loads the rules from the JSON like:
+//
+//	{
+//	    "rules": ["utm_*", "fbclid"],
+//	    "hosts": {"amazon.com": ["pf_rd_*", "ref"]}
+//	}
+//
+// The format is the own one of this package, it is not the data.min.json of
+// ClearURLs, whose providers match the urls and the names by regular
+// expressions.
+func (r *StripRules) LoadRulesJSON(rd io.Reader) error {
+	var v stripRulesJSON
+	if err := json.NewDecoder(rd).Decode(&v); err != nil {
+		return err
+	}
+	r.Add(v.Rules...)
+	for host, rules := range v.Hosts {
+		r.AddForHost(host, rules...)
+	}
+	return nil
+}
+
+// matchGlob reports whether the name matches the glob pattern, '*' matches
+// any bytes and '?' matches one byte.
+func matchGlob(pattern, name string) bool {
+	// The position to retry after the last '*'
+	star, next := -1, 0
+	p, n := 0, 0
+	for n < len(name) {
+		switch {
+		case p < len(pattern) && pattern[p] == '*':
+			star, next = p, n
+			p++
+		case p < len(pattern) && (pattern[p] == '?' || pattern[p] == name[n]):
+			p++
+			n++
+		case star >= 0:
+			// Let the '*' match one more byte
+			next++
+			p, n = star+1, next
+		default:
+			return false
+		}
+	}
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+	return p == len(pattern)
+}
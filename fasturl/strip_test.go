@@ -0,0 +1,98 @@
+package fasturl
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStripRules(t *testing.T) {
+	var r StripRules
+	r.Add("fbclid", "gclid", "utm_*", "mc_?id", "*_ref_*")
+	r.AddForHost("Amazon.com", "ref", "pf_rd_*")
+
+	for _, tt := range []struct {
+		input  string
+		expect string
+		n      int
+	}{
+		{"http://a/?utm_source=x&x=1&utm_medium=y&fbclid=z", "http://a/?x=1", 3},
+		{"http://a/?x=1&gclid=2&y=3&gclid=4", "http://a/?x=1&y=3", 2},
+		{"http://a/?mc_cid=1&mc_eid=2&mc_id=3&mc_abid=4", "http://a/?mc_id=3&mc_abid=4", 2},
+		{"http://a/?a_ref_b=1&_ref_=2&ref=3&xref=4", "http://a/?ref=3&xref=4", 2},
+		{"http://a/?utm=1&UTM_source=2", "http://a/?utm=1&UTM_source=2", 0},
+		{"http://amazon.com/?ref=1&pf_rd_p=2&q=3", "http://amazon.com/?q=3", 2},
+		{"http://www.amazon.com/?ref=1&q=3", "http://www.amazon.com/?q=3", 1},
+		{"http://notamazon.com/?ref=1&q=3", "http://notamazon.com/?ref=1&q=3", 0},
+		{"http://amazon.com.evil/?ref=1", "http://amazon.com.evil/?ref=1", 0},
+		{"http://a/?fbclid=1", "http://a/", 1},
+	} {
+		var f FastURL
+		require.Nil(t, f.Parse([]byte(tt.input)), tt.input)
+		require.Equal(t, tt.n, r.Strip(&f), tt.input)
+		require.Equal(t, tt.expect, string(f.Encode(nil)), tt.input)
+	}
+}
+
+func TestStripRulesLoad(t *testing.T) {
+	var r StripRules
+	err := r.Load(strings.NewReader(`
+# tracking parameters
+utm_*   # google
+fbclid
+
+[amazon.com]
+pf_rd_*
+ref
+`))
+	require.Nil(t, err)
+	require.True(t, r.Match([]byte("a"), []byte("utm_source")))
+	require.True(t, r.Match([]byte("a"), []byte("fbclid")))
+	require.False(t, r.Match([]byte("a"), []byte("ref")))
+	require.True(t, r.Match([]byte("smile.amazon.com"), []byte("ref")))
+	require.True(t, r.Match([]byte("amazon.com"), []byte("pf_rd_r")))
+
+	for _, input := range []string{"[]", "[amazon.com", "["} {
+		var r StripRules
+		require.NotNil(t, r.Load(strings.NewReader(input)), input)
+	}
+}
+
+func TestStripRulesLoadRulesJSON(t *testing.T) {
+	var r StripRules
+	err := r.LoadRulesJSON(strings.NewReader(`{
+		"rules": ["utm_*", "fbclid"],
+		"hosts": {"amazon.com": ["pf_rd_*", "ref"]}
+	}`))
+	require.Nil(t, err)
+	require.True(t, r.Match([]byte("a"), []byte("utm_source")))
+	require.False(t, r.Match([]byte("a"), []byte("ref")))
+	require.True(t, r.Match([]byte("www.amazon.com"), []byte("ref")))
+
+	require.NotNil(t, r.LoadRulesJSON(strings.NewReader(`{"rules": "utm_*"}`)))
+}
+
+func TestMatchGlob(t *testing.T) {
+	for _, tt := range []struct {
+		pattern string
+		name    string
+		expect  bool
+	}{
+		{"", "", true},
+		{"", "a", false},
+		{"*", "", true},
+		{"*", "abc", true},
+		{"a?c", "abc", true},
+		{"a?c", "ac", false},
+		{"a*c", "ac", true},
+		{"a*c", "abbbc", true},
+		{"a*c", "abbbcd", false},
+		{"*b*b*", "abcbd", true},
+		{"*b*b*", "abcd", false},
+		{"a**", "a", true},
+		{"*_id", "mc_id_id", true},
+	} {
+		require.Equal(t, tt.expect, matchGlob(tt.pattern, tt.name), tt.pattern+" "+tt.name)
+	}
+}
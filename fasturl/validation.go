@@ -0,0 +1,115 @@
+package fasturl
+
+// ValidationErrorKind is the kind of the ValidationError.
+//
+// More detail see https://url.spec.whatwg.org/#validation-error
+type ValidationErrorKind int
+
+const (
+	// ValidationInvalidURLUnit indicates a code point which is not a URL
+	// code point or a '%' which is not followed by two hex digits.
+	ValidationInvalidURLUnit ValidationErrorKind = 1 + iota
+	// ValidationSpecialSchemeMissingFollowingSolidus indicates a special
+	// protocol which is not followed by "//".
+	ValidationSpecialSchemeMissingFollowingSolidus
+	// ValidationInvalidReverseSolidus indicates a '\' used as a slash.
+	ValidationInvalidReverseSolidus
+	// ValidationInvalidCredentials indicates the url has a username or password.
+	ValidationInvalidCredentials
+	// ValidationIPv4EmptyPart indicates an IPv4 address with a trailing dot.
+	ValidationIPv4EmptyPart
+	// ValidationIPv4NonDecimalPart indicates an IPv4 address with an octal or
+	// hexadecimal part.
+	ValidationIPv4NonDecimalPart
+)
+
+// String returns the name of the validation error used by the WHATWG spec.
+func (k ValidationErrorKind) String() string {
+	switch k {
+	case ValidationInvalidURLUnit:
+		return "invalid-URL-unit"
+	case ValidationSpecialSchemeMissingFollowingSolidus:
+		return "special-scheme-missing-following-solidus"
+	case ValidationInvalidReverseSolidus:
+		return "invalid-reverse-solidus"
+	case ValidationInvalidCredentials:
+		return "invalid-credentials"
+	case ValidationIPv4EmptyPart:
+		return "IPv4-empty-part"
+	case ValidationIPv4NonDecimalPart:
+		return "IPv4-non-decimal-part"
+	}
+	return "unknown"
+}
+
+// ValidationError is a non-fatal violation of the WHATWG spec, the url is
+// parsed in spite of it.
+type ValidationError struct {
+	// Kind is the kind of the validation error.
+	Kind ValidationErrorKind
+	// Offset is the byte offset in the input.
+	Offset int
+}
+
+func (f *FastURL) addValidationError(kind ValidationErrorKind, offset int) {
+	f.validationErrors = append(f.validationErrors, ValidationError{
+		Kind:   kind,
+		Offset: offset,
+	})
+}
+
+// isURLCodePoint reports whether the ASCII c is a URL code point, every
+// non-ASCII byte is treated as part of one.
+//
+// More detail see https://url.spec.whatwg.org/#url-code-points
+func isURLCodePoint(c byte) bool {
+	switch {
+	case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9':
+		return true
+	case c >= 0x80:
+		return true
+	}
+	switch c {
+	case '!', '$', '&', '\'', '(', ')', '*', '+', ',', '-', '.', '/', ':', ';', '=', '?', '@', '_', '~':
+		return true
+	}
+	return false
+}
+
+// validateURLUnits reports the invalid URL units of src, which is at offset
+// base of the input. If special is true, '\' is reported as a slash.
+func (f *FastURL) validateURLUnits(src []byte, base int, special bool) {
+	for i := 0; i < len(src); i++ {
+		c := src[i]
+		switch {
+		case c == '%':
+			if i+2 >= len(src) || !ishex(src[i+1]) || !ishex(src[i+2]) {
+				f.addValidationError(ValidationInvalidURLUnit, base+i)
+			}
+		case c == '\\' && special:
+			f.addValidationError(ValidationInvalidReverseSolidus, base+i)
+		case !isURLCodePoint(c):
+			f.addValidationError(ValidationInvalidURLUnit, base+i)
+		}
+	}
+}
+
+// validateIPv4 reports the empty and non-decimal parts of the IPv4 address
+// src, which is at offset base of the input.
+func (f *FastURL) validateIPv4(src []byte, base int) {
+	start := 0
+	for i := 0; i <= len(src); i++ {
+		if i < len(src) && src[i] != '.' {
+			continue
+		}
+
+		part := src[start:i]
+		switch {
+		case len(part) == 0 && i == len(src):
+			f.addValidationError(ValidationIPv4EmptyPart, base+i-1)
+		case len(part) >= 2 && part[0] == '0':
+			f.addValidationError(ValidationIPv4NonDecimalPart, base+start)
+		}
+		start = i + 1
+	}
+}
@@ -0,0 +1,272 @@
+package fasturl
+
+import "bytes"
+
+// FastURLView is the url whose components are the subslices of the input,
+// nothing is copied or allocated by the parsing.
+//
+// The view splits the url like RFC 3986 appendix B and the special schemes
+// of WHATWG, but it does not normalize the components: the protocol and host
+// keep their case, the default port is kept, the userinfo is not
+// percent-encoded and the IPv6 address is not compressed. For the url which
+// is already normalized, like the one returned by FastURL.Encode, the
+// components are the same as the ones of FastURL.
+//
+// The host is split but not validated: the IPv4 and IPv6 addresses are not
+// parsed and the domain is not checked for the forbidden code points or
+// processed by IDNA. So CopyTo, which parses the url, may fail on the url the
+// view accepts, like http://1.2.3.256/.
+//
+// The components are valid as long as the input is, the input must not be
+// modified while the view is used. CopyTo makes a FastURL which owns its
+// components.
+type FastURLView struct {
+	url          []byte
+	protocol     []byte
+	scheme       *Scheme
+	auth         []byte
+	user         []byte
+	pass         []byte
+	host         []byte
+	hostname     []byte
+	port         []byte
+	pathname     []byte
+	rawquery     []byte
+	hash         []byte
+	hasAuthority bool
+	opaque       bool
+}
+
+// ParseView parses the url to the view, the components refer to the url.
+func ParseView(v *FastURLView, url []byte) error {
+	v.Reset()
+	v.url = url
+
+	// Find hash
+	if i := bytes.IndexByte(url, '#'); i >= 0 {
+		if err := checkASCIIControl(ComponentFragment, url[i+1:], i+1); err != nil {
+			return err
+		}
+		url, v.hash = url[:i], url[i:]
+	}
+
+	// Find query
+	queryIndex := bytes.IndexByte(url, '?')
+	if queryIndex >= 0 {
+		if err := checkASCIIControl(ComponentQuery, url[queryIndex+1:], queryIndex+1); err != nil {
+			return err
+		}
+		url, v.rawquery = url[:queryIndex], url[queryIndex+1:]
+	}
+
+	// Find protocol
+	pos := 0
+	if pi := bytes.IndexByte(url, ':'); pi >= 0 && isValidScheme(url[:pi]) {
+		v.protocol = url[:pi]
+		v.scheme = lookupSchemeFold(v.protocol)
+		pos = pi + 1
+	}
+	special := v.scheme != nil && v.scheme.Special
+
+	// The authority follows the rules of parse
+	v.hasAuthority = true
+	switch {
+	case special && v.scheme.Name == "file":
+		if len(url[pos:]) >= 2 && isSlash(url[pos], true) && isSlash(url[pos+1], true) {
+			pos += 2
+		} else {
+			v.hasAuthority = false
+		}
+	case special:
+		for pos < len(url) && isSlash(url[pos], true) {
+			pos++
+		}
+	case len(url[pos:]) >= 2 && string(url[pos:pos+2]) == "//":
+		pos += 2
+	case len(v.protocol) == 0:
+		// The url without protocol starts with the authority
+	case len(url[pos:]) > 0 && url[pos] == '/':
+		v.hasAuthority = false
+	default:
+		v.opaque = true
+		v.hasAuthority = false
+	}
+
+	if v.hasAuthority {
+		end := len(url)
+		for i := pos; i < len(url); i++ {
+			if isSlash(url[i], special) {
+				end = i
+				break
+			}
+		}
+		if err := v.parseAuthority(url[pos:end], pos); err != nil {
+			return err
+		}
+		pos = end
+	}
+
+	if err := checkASCIIControl(ComponentPath, url[pos:], pos); err != nil {
+		return err
+	}
+	v.pathname = url[pos:]
+	return nil
+}
+
+// parseAuthority splits the authority at offset base of the url.
+func (v *FastURLView) parseAuthority(authority []byte, base int) error {
+	if i := bytes.LastIndexByte(authority, '@'); i >= 0 {
+		if err := checkASCIIControl(ComponentUserinfo, authority[:i], base); err != nil {
+			return err
+		}
+		v.auth = authority[:i]
+		if ci := bytes.IndexByte(v.auth, ':'); ci >= 0 {
+			v.user, v.pass = v.auth[:ci], v.auth[ci+1:]
+		} else {
+			v.user = v.auth
+		}
+		authority = authority[i+1:]
+		base += i + 1
+	}
+	v.host = authority
+	if pi := portIndex(authority); pi >= 0 {
+		if err := checkASCIIControl(ComponentHost, authority[:pi], base); err != nil {
+			return err
+		}
+		if err := checkASCIIControl(ComponentPort, authority[pi+1:], base+pi+1); err != nil {
+			return err
+		}
+	} else if err := checkASCIIControl(ComponentHost, authority, base); err != nil {
+		return err
+	}
+
+	// The host and port are split on the first ':' after the IPv6 address
+	// like parse
+	if len(authority) > 0 && authority[0] == '[' {
+		ei := bytes.IndexByte(authority, ']')
+		if ei < 0 {
+			return newParseError(ComponentHost, ErrorKindInvalidIPv6, authority, base, 0, len(authority))
+		}
+		if ei+1 < len(authority) && authority[ei+1] != ':' {
+			return newParseError(ComponentHost, ErrorKindInvalidCharacter, authority, base, ei+1, 1)
+		}
+	}
+	v.hostname = authority
+	if pi := portIndex(authority); pi >= 0 {
+		v.hostname, v.port = authority[:pi], authority[pi+1:]
+		return checkPort(v.port, base+pi+1)
+	}
+	return nil
+}
+
+// lookupSchemeFold looks up the scheme of the protocol in any case, only the
+// long protocol with uppercase letters is lowercased on the heap.
+func lookupSchemeFold(protocol []byte) *Scheme {
+	upper := false
+	for _, c := range protocol {
+		if 'A' <= c && c <= 'Z' {
+			upper = true
+			break
+		}
+	}
+	if !upper {
+		return lookupScheme(protocol)
+	}
+
+	var buf [16]byte
+	lower := append(buf[:0], protocol...)
+	toLowercsaeASCII(lower)
+	return lookupScheme(lower)
+}
+
+// Parse parses the url to the view.
+func (v *FastURLView) Parse(url []byte) error {
+	return ParseView(v, url)
+}
+
+// Reset resets the view, the input is not referred to any more.
+func (v *FastURLView) Reset() {
+	*v = FastURLView{}
+}
+
+// CopyTo parses the url of the view to f, the components of f are owned by f
+// and normalized like Parse. It fails on the invalid host the view does not
+// check.
+func (v *FastURLView) CopyTo(f *FastURL) error {
+	f.Reset()
+	return Parse(f, v.url)
+}
+
+// GetURL gets the input of the view.
+func (v *FastURLView) GetURL() []byte {
+	return v.url
+}
+
+// GetProtocol gets the protocol.
+func (v *FastURLView) GetProtocol() []byte {
+	return v.protocol
+}
+
+// GetScheme gets a copy of the registered scheme of the protocol, the bool is
+// false if the protocol is not registered.
+func (v *FastURLView) GetScheme() (Scheme, bool) {
+	if v.scheme == nil {
+		return Scheme{}, false
+	}
+	return *v.scheme, true
+}
+
+// GetAuth gets the auth.
+func (v *FastURLView) GetAuth() []byte {
+	return v.auth
+}
+
+// GetUser gets the user.
+func (v *FastURLView) GetUser() []byte {
+	return v.user
+}
+
+// GetPass gets the password.
+func (v *FastURLView) GetPass() []byte {
+	return v.pass
+}
+
+// GetHost gets the host which include hostname and port.
+func (v *FastURLView) GetHost() []byte {
+	return v.host
+}
+
+// GetHostname gets the hostname, the IPv6 address is with brackets.
+func (v *FastURLView) GetHostname() []byte {
+	return v.hostname
+}
+
+// GetPort gets the port.
+func (v *FastURLView) GetPort() []byte {
+	return v.port
+}
+
+// GetPathname gets the pathname.
+func (v *FastURLView) GetPathname() []byte {
+	return v.pathname
+}
+
+// IsOpaque reports whether the url has an opaque path.
+func (v *FastURLView) IsOpaque() bool {
+	return v.opaque
+}
+
+// HasAuthority reports whether the url has an authority, which may be empty.
+func (v *FastURLView) HasAuthority() bool {
+	return v.hasAuthority
+}
+
+// GetRawQuery gets the raw query string.
+func (v *FastURLView) GetRawQuery() []byte {
+	return v.rawquery
+}
+
+// GetHash gets the hash.
+func (v *FastURLView) GetHash() []byte {
+	return v.hash
+}
@@ -3,6 +3,7 @@
 package url
 
 import (
+	"bytes"
 	"fmt"
 	"reflect"
 
@@ -69,3 +70,82 @@ func FuzzQuery(data []byte) int {
 	}
 	return 1
 }
+
+// FuzzSetters sets the components of a url from data, the url Encode returns
+// must parse back to the same url and the components must be the set values
+// escaped by Encode, which are compared percent-decoded.
+func FuzzSetters(data []byte) int {
+	if len(data) == 0 {
+		return 0
+	}
+
+	bases := []string{"http://x/", "foo://x/", "foo:/", "mailto:x"}
+	base := bases[int(data[0])%len(bases)]
+
+	var parts [4][]byte
+	for i, p := range bytes.SplitN(data[1:], []byte{0}, len(parts)) {
+		parts[i] = p
+	}
+	user, pass, pathname, hash := parts[0], parts[1], parts[2], parts[3]
+
+	var u1 fasturl.FastURL
+	if err := fasturl.Parse(&u1, []byte(base)); err != nil {
+		panic(err)
+	}
+	if u1.IsOpaque() && bytes.HasPrefix(pathname, []byte("/")) {
+		// The opaque path can not start with '/'
+		return 0
+	}
+	hasHost := len(u1.GetHostname()) > 0
+	if hasHost {
+		u1.SetUser(string(user))
+		u1.SetPass(string(pass))
+	}
+	u1.SetPathname(string(pathname))
+	u1.SetHash(string(hash))
+
+	d1 := u1.Encode(nil)
+
+	var u2 fasturl.FastURL
+	if err := fasturl.Parse(&u2, d1); err != nil {
+		fmt.Printf("url: %#v\n", string(d1))
+		panic(err)
+	}
+
+	d2 := u2.Encode(nil)
+	if !bytes.Equal(d1, d2) {
+		fmt.Printf("url1: %#v\n", string(d1))
+		fmt.Printf("url2: %#v\n", string(d2))
+		panic("fail")
+	}
+
+	// Encode makes the path after an authority absolute, prefixes the path
+	// without an authority which starts with "//" by "/." and drops the
+	// leading '#' of the hash
+	switch {
+	case hasHost && !bytes.HasPrefix(pathname, []byte("/")):
+		pathname = append([]byte("/"), pathname...)
+	case !hasHost && bytes.HasPrefix(pathname, []byte("//")):
+		pathname = append([]byte("/."), pathname...)
+	}
+	hash = bytes.TrimPrefix(hash, []byte("#"))
+	for _, c := range []struct {
+		name     string
+		set, got []byte
+	}{
+		{"user", user, u2.GetUser()},
+		{"pass", pass, u2.GetPass()},
+		{"pathname", pathname, u2.GetPathname()},
+		{"hash", hash, bytes.TrimPrefix(u2.GetHash(), []byte("#"))},
+	} {
+		if !hasHost && (c.name == "user" || c.name == "pass") {
+			continue
+		}
+		if !bytes.Equal(fasturl.PercentDecode(nil, c.set), fasturl.PercentDecode(nil, c.got)) {
+			fmt.Printf("url: %#v\n", string(d1))
+			fmt.Printf("%s: %#v != %#v\n", c.name, string(c.set), string(c.got))
+			panic("fail")
+		}
+	}
+	return 1
+}